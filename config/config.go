@@ -1,6 +1,49 @@
 package config
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// getDuration reads a Go duration string (e.g. "720h") from the environment,
+// falling back to the default when unset or malformed
+func getDuration(key string, fallback time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return fallback
+	}
+	return d
+}
+
+// getInt reads an integer from the environment, falling back to the default when unset or malformed
+func getInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// getList reads a comma separated list from the environment
+func getList(key string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
 
 // Database Configuration
 func GetDatabaseURL() string {
@@ -28,6 +71,246 @@ func GetAllowedOrigins() string {
 	return origins
 }
 
+// GetAllowedOriginList splits ALLOWED_ORIGINS into its origins
+func GetAllowedOriginList() []string {
+	var origins []string
+	for _, origin := range strings.Split(GetAllowedOrigins(), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, strings.TrimSuffix(origin, "/"))
+		}
+	}
+	return origins
+}
+
+// GetTrustedProxies lists the proxy IPs/CIDRs whose X-Forwarded-For header is trusted
+func GetTrustedProxies() []string {
+	return getList("TRUSTED_PROXIES")
+}
+
+// Session Configuration
+
+// GetSessionIdleTimeout is how long a session stays valid without activity
+func GetSessionIdleTimeout() time.Duration {
+	return getDuration("SESSION_IDLE_TIMEOUT", 30*24*time.Hour)
+}
+
+// GetSessionAbsoluteLifetime is the hard limit on a session's age, measured
+// from its creation, regardless of activity
+func GetSessionAbsoluteLifetime() time.Duration {
+	return getDuration("SESSION_ABSOLUTE_LIFETIME", 90*24*time.Hour)
+}
+
+// GetSessionRenewalThreshold is how long after its last renewal a session's
+// expiry is slid forward again on the next request
+func GetSessionRenewalThreshold() time.Duration {
+	return getDuration("SESSION_RENEWAL_THRESHOLD", 24*time.Hour)
+}
+
+// GetSessionLastSeenInterval throttles how often a session's last_seen_at is written
+func GetSessionLastSeenInterval() time.Duration {
+	return getDuration("SESSION_LAST_SEEN_INTERVAL", 5*time.Minute)
+}
+
+// Token Configuration
+
+// GetJWTSigningKeyPaths lists PEM encoded RSA private keys used for access tokens.
+// The first key signs new tokens; keep retired keys after it until their tokens expire.
+func GetJWTSigningKeyPaths() []string {
+	return getList("JWT_SIGNING_KEYS")
+}
+
+func GetJWTIssuer() string {
+	issuer := os.Getenv("JWT_ISSUER")
+	if issuer == "" {
+		return GetURL()
+	}
+	return issuer
+}
+
+func GetAccessTokenTTL() time.Duration {
+	return getDuration("ACCESS_TOKEN_TTL", 15*time.Minute)
+}
+
+func GetRefreshTokenTTL() time.Duration {
+	return getDuration("REFRESH_TOKEN_TTL", 30*24*time.Hour)
+}
+
+// MFA Configuration
+
+// GetMFAIssuer is the name authenticator apps show next to the account
+func GetMFAIssuer() string {
+	issuer := os.Getenv("MFA_ISSUER")
+	if issuer == "" {
+		return "YourAppName"
+	}
+	return issuer
+}
+
+// GetMFAChallengeTTL is how long a user has to enter their second factor after the first
+func GetMFAChallengeTTL() time.Duration {
+	return getDuration("MFA_CHALLENGE_TTL", 5*time.Minute)
+}
+
+// GetReauthMaxAge is how long after logging in or re-authenticating sensitive operations are allowed
+func GetReauthMaxAge() time.Duration {
+	return getDuration("REAUTH_MAX_AGE", 10*time.Minute)
+}
+
+// OTP Configuration
+
+// otpTTLDefaults are the lifetimes of one-time codes by purpose
+var otpTTLDefaults = map[string]time.Duration{
+	"login":              10 * time.Minute,
+	"password_reset":     time.Hour,
+	"reauthentication":   10 * time.Minute,
+	"email_verification": 24 * time.Hour,
+	"phone_verification": 10 * time.Minute,
+	"email_change":       time.Hour,
+	"account_link":       15 * time.Minute,
+}
+
+// GetOTPTTL is how long a one-time code of the given type stays valid,
+// configurable per type as OTP_TTL_<TYPE> (e.g. OTP_TTL_PASSWORD_RESET=1h)
+func GetOTPTTL(otpType string) time.Duration {
+	fallback, ok := otpTTLDefaults[otpType]
+	if !ok {
+		fallback = 10 * time.Minute
+	}
+	return getDuration("OTP_TTL_"+strings.ToUpper(otpType), fallback)
+}
+
+// GetOTPMaxAttempts is the number of wrong guesses after which a code is burned
+func GetOTPMaxAttempts() int {
+	return getInt("OTP_MAX_ATTEMPTS", 5)
+}
+
+// Email Verification Configuration
+
+// GetEmailVerificationPolicy decides what unverified users may do once logged in:
+// "block" rejects every authenticated request, "allowlist" only allows the routes
+// in GetEmailVerificationAllowedRoutes and "off" disables the check. It defaults to
+// "off", since users who signed up before verification existed were never sent a code.
+func GetEmailVerificationPolicy() string {
+	policy := os.Getenv("EMAIL_VERIFICATION_POLICY")
+	if policy == "" {
+		return "off"
+	}
+	return policy
+}
+
+// GetEmailVerificationAllowedRoutes lists "METHOD /path" entries unverified users
+// may call under the allowlist policy. A trailing * matches any path with that prefix.
+func GetEmailVerificationAllowedRoutes() []string {
+	routes := getList("EMAIL_VERIFICATION_ALLOWED_ROUTES")
+	if len(routes) == 0 {
+		return []string{
+			"GET /users/me",
+			"GET /users/profile",
+			"PATCH /users/profile",
+			"DELETE /auth/logout",
+		}
+	}
+	return routes
+}
+
+// GetEmailVerificationResendCooldown is the minimum time between verification emails
+func GetEmailVerificationResendCooldown() time.Duration {
+	return getDuration("EMAIL_VERIFICATION_RESEND_COOLDOWN", time.Minute)
+}
+
+// GetEmailChangeRevertTTL is how long the old address can undo an email change
+func GetEmailChangeRevertTTL() time.Duration {
+	return getDuration("EMAIL_CHANGE_REVERT_TTL", 7*24*time.Hour)
+}
+
+// GetMagicLinkTTL is how long an emailed login link stays valid
+func GetMagicLinkTTL() time.Duration {
+	return getDuration("MAGIC_LINK_TTL", 15*time.Minute)
+}
+
+// Login Throttling Configuration
+
+// GetLoginAccountBackoffAfter is the number of failed logins for an account before delays start
+func GetLoginAccountBackoffAfter() int {
+	return getInt("LOGIN_ACCOUNT_BACKOFF_AFTER", 3)
+}
+
+// GetLoginAccountLockoutAfter is the number of failed logins that lock an account
+func GetLoginAccountLockoutAfter() int {
+	return getInt("LOGIN_ACCOUNT_LOCKOUT_AFTER", 10)
+}
+
+// GetLoginIPBackoffAfter is the number of failed logins from one IP before delays start
+func GetLoginIPBackoffAfter() int {
+	return getInt("LOGIN_IP_BACKOFF_AFTER", 20)
+}
+
+// GetLoginIPLockoutAfter is the number of failed logins that lock out an IP
+func GetLoginIPLockoutAfter() int {
+	return getInt("LOGIN_IP_LOCKOUT_AFTER", 100)
+}
+
+// GetLoginBackoffBase is the first delay once backoff starts, doubled on every further failure
+func GetLoginBackoffBase() time.Duration {
+	return getDuration("LOGIN_BACKOFF_BASE", time.Second)
+}
+
+// GetLoginLockoutDuration is how long a lockout lasts, and the cap for backoff delays
+func GetLoginLockoutDuration() time.Duration {
+	return getDuration("LOGIN_LOCKOUT_DURATION", 15*time.Minute)
+}
+
+// GetLoginFailureWindow is how long without failures before the count starts over
+func GetLoginFailureWindow() time.Duration {
+	return getDuration("LOGIN_FAILURE_WINDOW", time.Hour)
+}
+
+// Rate Limiting Configuration
+
+// GetRateLimitStore selects where rate limit counters live: "memory" for a
+// single instance, "postgres" to share them between replicas
+func GetRateLimitStore() string {
+	store := os.Getenv("RATE_LIMIT_STORE")
+	if store == "" {
+		return "memory"
+	}
+	return store
+}
+
+// Passkey Configuration
+
+// GetWebAuthnRPID is the domain passkeys are bound to, the app domain without its port by default
+func GetWebAuthnRPID() string {
+	rpID := os.Getenv("WEBAUTHN_RP_ID")
+	if rpID == "" {
+		rpID, _, _ = strings.Cut(GetAppDomain(), ":")
+	}
+	return rpID
+}
+
+// GetWebAuthnRPName is the name browsers show when creating or using a passkey
+func GetWebAuthnRPName() string {
+	name := os.Getenv("WEBAUTHN_RP_NAME")
+	if name == "" {
+		return GetMFAIssuer()
+	}
+	return name
+}
+
+// GetWebAuthnOrigins lists the origins ceremonies may come from, the CORS origins by default
+func GetWebAuthnOrigins() []string {
+	origins := getList("WEBAUTHN_ORIGINS")
+	if len(origins) == 0 {
+		return GetAllowedOriginList()
+	}
+	return origins
+}
+
+// GetWebAuthnChallengeTTL is how long a passkey registration or login may take
+func GetWebAuthnChallengeTTL() time.Duration {
+	return getDuration("WEBAUTHN_CHALLENGE_TTL", 5*time.Minute)
+}
+
 // External Services
 func GetPosthogKey() string {
 	return os.Getenv("POSTHOG_KEY")
@@ -54,17 +337,63 @@ func GetTwilioPhoneNumber() string {
 	return phoneNumber
 }
 
-// OAuth Configuration
-func GetGoogleClientID() string {
-	return os.Getenv("GOOGLE_CLIENT_ID")
+// OAuth Configuration, see oauth.go for the generic providers
+
+// GetAppleClientID is the Services ID web logins are issued for
+func GetAppleClientID() string {
+	return os.Getenv("APPLE_CLIENT_ID")
+}
+
+// GetAppleBundleIDs lists the app bundle IDs native identity tokens may be issued for
+func GetAppleBundleIDs() []string {
+	return getList("APPLE_BUNDLE_IDS")
 }
 
-func GetGoogleClientSecret() string {
-	return os.Getenv("GOOGLE_CLIENT_SECRET")
+func GetAppleTeamID() string {
+	return os.Getenv("APPLE_TEAM_ID")
 }
 
-func GetGoogleRedirectURL() string {
-	return os.Getenv("GOOGLE_REDIRECT_URL")
+// GetAppleKeyID is the ID of the Sign in with Apple key at GetApplePrivateKeyPath
+func GetAppleKeyID() string {
+	return os.Getenv("APPLE_KEY_ID")
+}
+
+// GetApplePrivateKeyPath is the .p8 key the client secret JWT is signed with
+func GetApplePrivateKeyPath() string {
+	return os.Getenv("APPLE_PRIVATE_KEY_PATH")
+}
+
+func GetAppleRedirectURL() string {
+	return os.Getenv("APPLE_REDIRECT_URL")
+}
+
+// GetApplePostLoginRedirect is where browsers are sent after Apple posts the
+// web callback directly, the first ALLOWED_ORIGINS entry by default
+func GetApplePostLoginRedirect() string {
+	if redirect := os.Getenv("APPLE_POST_LOGIN_REDIRECT"); redirect != "" {
+		return redirect
+	}
+	if origins := GetAllowedOriginList(); len(origins) > 0 {
+		return origins[0]
+	}
+	return GetURL()
+}
+
+// GetAppleJWKSURL is where identity token signing keys are fetched from,
+// overridable to point at a local stub
+func GetAppleJWKSURL() string {
+	if url := os.Getenv("APPLE_JWKS_URL"); url != "" {
+		return url
+	}
+	return "https://appleid.apple.com/auth/keys"
+}
+
+// GetAppleTokenURL is where authorization codes are exchanged, overridable like GetAppleJWKSURL
+func GetAppleTokenURL() string {
+	if url := os.Getenv("APPLE_TOKEN_URL"); url != "" {
+		return url
+	}
+	return "https://appleid.apple.com/auth/token"
 }
 
 // Application Configuration
@@ -1,29 +1,84 @@
 package config
 
 import (
-	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/google"
+	"os"
+	"strings"
+	"time"
 )
 
-// ConfigGoogle to set config of oauth
-func ConfigGoogle() *oauth2.Config {
-	clientID := GetGoogleClientID()
-	clientSecret := GetGoogleClientSecret()
-	redirectURL := GetGoogleRedirectURL()
+// OAuthClient holds the credentials registered with an OAuth provider
+type OAuthClient struct {
+	ID          string
+	Secret      string
+	RedirectURL string
+}
 
-	if clientID == "" || clientSecret == "" || redirectURL == "" {
-		panic("GOOGLE_CLIENT_ID, GOOGLE_CLIENT_SECRET, or GOOGLE_REDIRECT_URL is not set")
+// GetOAuthClient reads <PROVIDER>_CLIENT_ID, <PROVIDER>_CLIENT_SECRET and
+// <PROVIDER>_REDIRECT_URL. ok is false when any of them is missing, which
+// leaves the provider disabled.
+func GetOAuthClient(provider string) (OAuthClient, bool) {
+	prefix := strings.ToUpper(provider) + "_"
+	client := OAuthClient{
+		ID:          os.Getenv(prefix + "CLIENT_ID"),
+		Secret:      os.Getenv(prefix + "CLIENT_SECRET"),
+		RedirectURL: os.Getenv(prefix + "REDIRECT_URL"),
 	}
+	return client, client.ID != "" && client.Secret != "" && client.RedirectURL != ""
+}
+
+// GetOAuthLinkPolicy decides what happens when a provider login matches the
+// email of an existing user. "verified" links automatically when both the
+// provider and the user have verified the address and asks the user to confirm
+// otherwise, "confirm" always asks and "never" refuses, leaving linking to the
+// linked accounts settings.
+func GetOAuthLinkPolicy() string {
+	policy := os.Getenv("OAUTH_LINK_POLICY")
+	if policy == "" {
+		return "verified"
+	}
+	return policy
+}
+
+// GetAccountLinkTTL is how long the user has to confirm linking a provider to their account
+func GetAccountLinkTTL() time.Duration {
+	return getDuration("ACCOUNT_LINK_TTL", 15*time.Minute)
+}
+
+// GetOAuthFlowTTL is how long the user has to come back from a provider's consent page
+func GetOAuthFlowTTL() time.Duration {
+	return getDuration("OAUTH_FLOW_TTL", 10*time.Minute)
+}
+
+// GetOIDCIssuerURL is the issuer of the generic OpenID Connect provider, whose
+// endpoints are discovered from <issuer>/.well-known/openid-configuration
+func GetOIDCIssuerURL() string {
+	return strings.TrimSuffix(os.Getenv("OIDC_ISSUER_URL"), "/")
+}
+
+// GetOIDCScopes are requested from the generic OpenID Connect provider
+func GetOIDCScopes() []string {
+	scopes := getList("OIDC_SCOPES")
+	if len(scopes) == 0 {
+		return []string{"openid", "email", "profile"}
+	}
+	return scopes
+}
+
+// GetGoogleClientIDs lists the client IDs, web, iOS and Android, that native
+// Google ID tokens may be issued for. Defaults to GOOGLE_CLIENT_ID.
+func GetGoogleClientIDs() []string {
+	ids := getList("GOOGLE_CLIENT_IDS")
+	if len(ids) == 0 && os.Getenv("GOOGLE_CLIENT_ID") != "" {
+		return []string{os.Getenv("GOOGLE_CLIENT_ID")}
+	}
+	return ids
+}
 
-	conf := &oauth2.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		RedirectURL:  redirectURL,
-		Scopes: []string{
-			"https://www.googleapis.com/auth/userinfo.email",
-			"https://www.googleapis.com/auth/userinfo.profile",
-		},
-		Endpoint: google.Endpoint,
+// GetGoogleJWKSURL is where ID token signing keys are fetched from, overridable
+// to point at a local stub
+func GetGoogleJWKSURL() string {
+	if url := os.Getenv("GOOGLE_JWKS_URL"); url != "" {
+		return url
 	}
-	return conf
+	return "https://www.googleapis.com/oauth2/v3/certs"
 }
@@ -0,0 +1,42 @@
+package config
+
+import "os"
+
+// GetPasswordMinLength is the shortest password accepted, capped at GetPasswordMaxLength
+func GetPasswordMinLength() int {
+	return getInt("PASSWORD_MIN_LENGTH", 8)
+}
+
+// GetPasswordMaxLength is the longest password accepted, at most 128 characters
+func GetPasswordMaxLength() int {
+	maxLength := getInt("PASSWORD_MAX_LENGTH", 128)
+	if maxLength > 128 || maxLength < 1 {
+		return 128
+	}
+	return maxLength
+}
+
+// GetPasswordRequiredClasses lists the character classes a password must
+// contain, any of "lower", "upper", "digit" and "symbol"
+func GetPasswordRequiredClasses() []string {
+	return getList("PASSWORD_REQUIRED_CLASSES")
+}
+
+// GetPasswordMinEntropy is the estimated strength in bits a password needs,
+// 0 turns the check off. See password.Entropy for how it is estimated.
+func GetPasswordMinEntropy() int {
+	return getInt("PASSWORD_MIN_ENTROPY", 30)
+}
+
+// GetPasswordBreachDir holds SHA-1 hash range files named by their 5 character
+// prefix, e.g. 21BD1.txt, in the format of the Have I Been Pwned range API.
+// Empty turns the breached password check off.
+func GetPasswordBreachDir() string {
+	return os.Getenv("PASSWORD_BREACH_DIR")
+}
+
+// GetPasswordBreachThreshold is how many times a password may appear in
+// breaches before it is rejected
+func GetPasswordBreachThreshold() int {
+	return getInt("PASSWORD_BREACH_THRESHOLD", 1)
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// GetStorageDriver selects where uploaded files are kept, "local" or "s3"
+func GetStorageDriver() string {
+	driver := os.Getenv("STORAGE_DRIVER")
+	if driver == "" {
+		return "local"
+	}
+	return driver
+}
+
+// GetStorageLocalDir is the directory the local driver writes to, served under /uploads
+func GetStorageLocalDir() string {
+	dir := os.Getenv("STORAGE_LOCAL_DIR")
+	if dir == "" {
+		return "uploads"
+	}
+	return dir
+}
+
+// GetStoragePublicURL is the base URL stored files are linked from. Defaults to
+// the /uploads route of this server for the local driver and to the bucket's
+// path on the endpoint for S3.
+func GetStoragePublicURL() string {
+	if url := os.Getenv("STORAGE_PUBLIC_URL"); url != "" {
+		return strings.TrimSuffix(url, "/")
+	}
+	if GetStorageDriver() == "s3" {
+		scheme := "https://"
+		if !GetS3UseSSL() {
+			scheme = "http://"
+		}
+		return scheme + GetS3Endpoint() + "/" + GetS3Bucket()
+	}
+	scheme := "http://"
+	if GetIsProduction() {
+		scheme = "https://"
+	}
+	return scheme + GetAppDomain() + "/uploads"
+}
+
+// GetS3Endpoint is the host of the S3 compatible service, e.g. localhost:9000 for MinIO
+func GetS3Endpoint() string {
+	endpoint := os.Getenv("S3_ENDPOINT")
+	if endpoint == "" {
+		return "s3.amazonaws.com"
+	}
+	return endpoint
+}
+
+func GetS3Bucket() string {
+	return os.Getenv("S3_BUCKET")
+}
+
+func GetS3Region() string {
+	return os.Getenv("S3_REGION")
+}
+
+func GetS3AccessKey() string {
+	return os.Getenv("S3_ACCESS_KEY")
+}
+
+func GetS3SecretKey() string {
+	return os.Getenv("S3_SECRET_KEY")
+}
+
+// GetS3UseSSL is turned off for local stand-ins served over plain HTTP
+func GetS3UseSSL() bool {
+	useSSL, err := strconv.ParseBool(os.Getenv("S3_USE_SSL"))
+	if err != nil {
+		return true
+	}
+	return useSSL
+}
+
+// GetS3PathStyle addresses the bucket in the path instead of the host name,
+// which most S3 stand-ins need
+func GetS3PathStyle() bool {
+	pathStyle, _ := strconv.ParseBool(os.Getenv("S3_PATH_STYLE"))
+	return pathStyle
+}
+
+// Avatar Configuration
+
+// GetAvatarMaxBytes is the largest avatar upload accepted, which has to stay
+// below the server's 4MB body limit
+func GetAvatarMaxBytes() int {
+	return getInt("AVATAR_MAX_BYTES", 2*1024*1024)
+}
+
+// GetAvatarSizes are the square edge lengths in pixels uploads are resized to.
+// The middle one is used as the profile's avatar_url.
+func GetAvatarSizes() []int {
+	var sizes []int
+	for _, item := range getList("AVATAR_SIZES") {
+		if size, err := strconv.Atoi(item); err == nil && size > 0 {
+			sizes = append(sizes, size)
+		}
+	}
+	if len(sizes) == 0 {
+		return []int{64, 256, 512}
+	}
+	return sizes
+}
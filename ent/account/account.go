@@ -90,9 +90,13 @@ type Type string
 
 // Type values.
 const (
-	TypePassword Type = "password"
-	TypeGoogle   Type = "google"
-	TypeApple    Type = "apple"
+	TypePassword  Type = "password"
+	TypeGoogle    Type = "google"
+	TypeApple     Type = "apple"
+	TypeGithub    Type = "github"
+	TypeMicrosoft Type = "microsoft"
+	TypeGitlab    Type = "gitlab"
+	TypeOidc      Type = "oidc"
 )
 
 func (_type Type) String() string {
@@ -102,7 +106,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypePassword, TypeGoogle, TypeApple:
+	case TypePassword, TypeGoogle, TypeApple, TypeGithub, TypeMicrosoft, TypeGitlab, TypeOidc:
 		return nil
 	default:
 		return fmt.Errorf("account: invalid enum value for type field: %q", _type)
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// AccountLink is the model entity for the AccountLink schema.
+type AccountLink struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Type holds the value of the "type" field.
+	Type accountlink.Type `json:"type,omitempty"`
+	// ProviderID holds the value of the "provider_id" field.
+	ProviderID string `json:"-"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountLinkQuery when eager-loading is set.
+	Edges              AccountLinkEdges `json:"edges"`
+	user_account_links *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// AccountLinkEdges holds the relations/edges for other nodes in the graph.
+type AccountLinkEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// MfaChallenge holds the value of the mfa_challenge edge.
+	MfaChallenge *MFAChallenge `json:"mfa_challenge,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountLinkEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// MfaChallengeOrErr returns the MfaChallenge value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountLinkEdges) MfaChallengeOrErr() (*MFAChallenge, error) {
+	if e.MfaChallenge != nil {
+		return e.MfaChallenge, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: mfachallenge.Label}
+	}
+	return nil, &NotLoadedError{edge: "mfa_challenge"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accountlink.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case accountlink.FieldTokenHash, accountlink.FieldType, accountlink.FieldProviderID:
+			values[i] = new(sql.NullString)
+		case accountlink.FieldCreatedAt, accountlink.FieldUpdatedAt, accountlink.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case accountlink.FieldID:
+			values[i] = new(uuid.UUID)
+		case accountlink.ForeignKeys[0]: // user_account_links
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountLink fields.
+func (_m *AccountLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accountlink.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case accountlink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case accountlink.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case accountlink.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case accountlink.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = accountlink.Type(value.String)
+			}
+		case accountlink.FieldProviderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_id", values[i])
+			} else if value.Valid {
+				_m.ProviderID = value.String
+			}
+		case accountlink.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case accountlink.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case accountlink.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_account_links", values[i])
+			} else if value.Valid {
+				_m.user_account_links = new(uuid.UUID)
+				*_m.user_account_links = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountLink.
+// This includes values selected through modifiers, order, etc.
+func (_m *AccountLink) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AccountLink entity.
+func (_m *AccountLink) QueryUser() *UserQuery {
+	return NewAccountLinkClient(_m.config).QueryUser(_m)
+}
+
+// QueryMfaChallenge queries the "mfa_challenge" edge of the AccountLink entity.
+func (_m *AccountLink) QueryMfaChallenge() *MFAChallengeQuery {
+	return NewAccountLinkClient(_m.config).QueryMfaChallenge(_m)
+}
+
+// Update returns a builder for updating this AccountLink.
+// Note that you need to call AccountLink.Unwrap() before calling this method if this AccountLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *AccountLink) Update() *AccountLinkUpdateOne {
+	return NewAccountLinkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the AccountLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *AccountLink) Unwrap() *AccountLink {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountLink is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *AccountLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountLink(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("provider_id=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountLinks is a parsable slice of AccountLink.
+type AccountLinks []*AccountLink
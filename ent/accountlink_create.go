@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// AccountLinkCreate is the builder for creating a AccountLink entity.
+type AccountLinkCreate struct {
+	config
+	mutation *AccountLinkMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *AccountLinkCreate) SetCreatedAt(v time.Time) *AccountLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableCreatedAt(v *time.Time) *AccountLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *AccountLinkCreate) SetUpdatedAt(v time.Time) *AccountLinkCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableUpdatedAt(v *time.Time) *AccountLinkCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *AccountLinkCreate) SetTokenHash(v string) *AccountLinkCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *AccountLinkCreate) SetType(v accountlink.Type) *AccountLinkCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetProviderID sets the "provider_id" field.
+func (_c *AccountLinkCreate) SetProviderID(v string) *AccountLinkCreate {
+	_c.mutation.SetProviderID(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *AccountLinkCreate) SetAttempts(v int) *AccountLinkCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableAttempts(v *int) *AccountLinkCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *AccountLinkCreate) SetExpiresAt(v time.Time) *AccountLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableExpiresAt(v *time.Time) *AccountLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *AccountLinkCreate) SetID(v uuid.UUID) *AccountLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableID(v *uuid.UUID) *AccountLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *AccountLinkCreate) SetUserID(id uuid.UUID) *AccountLinkCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *AccountLinkCreate) SetUser(v *User) *AccountLinkCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID.
+func (_c *AccountLinkCreate) SetMfaChallengeID(id uuid.UUID) *AccountLinkCreate {
+	_c.mutation.SetMfaChallengeID(id)
+	return _c
+}
+
+// SetNillableMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID if the given value is not nil.
+func (_c *AccountLinkCreate) SetNillableMfaChallengeID(id *uuid.UUID) *AccountLinkCreate {
+	if id != nil {
+		_c = _c.SetMfaChallengeID(*id)
+	}
+	return _c
+}
+
+// SetMfaChallenge sets the "mfa_challenge" edge to the MFAChallenge entity.
+func (_c *AccountLinkCreate) SetMfaChallenge(v *MFAChallenge) *AccountLinkCreate {
+	return _c.SetMfaChallengeID(v.ID)
+}
+
+// Mutation returns the AccountLinkMutation object of the builder.
+func (_c *AccountLinkCreate) Mutation() *AccountLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the AccountLink in the database.
+func (_c *AccountLinkCreate) Save(ctx context.Context) (*AccountLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *AccountLinkCreate) SaveX(ctx context.Context) *AccountLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *AccountLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := accountlink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := accountlink.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := accountlink.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := accountlink.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := accountlink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *AccountLinkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountLink.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "AccountLink.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AccountLink.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := accountlink.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "AccountLink.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AccountLink.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := accountlink.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AccountLink.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProviderID(); !ok {
+		return &ValidationError{Name: "provider_id", err: errors.New(`ent: missing required field "AccountLink.provider_id"`)}
+	}
+	if v, ok := _c.mutation.ProviderID(); ok {
+		if err := accountlink.ProviderIDValidator(v); err != nil {
+			return &ValidationError{Name: "provider_id", err: fmt.Errorf(`ent: validator failed for field "AccountLink.provider_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "AccountLink.attempts"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AccountLink.expires_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "AccountLink.user"`)}
+	}
+	return nil
+}
+
+func (_c *AccountLinkCreate) sqlSave(ctx context.Context) (*AccountLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *AccountLinkCreate) createSpec() (*AccountLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(accountlink.Table, sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(accountlink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountlink.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(accountlink.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(accountlink.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.ProviderID(); ok {
+		_spec.SetField(accountlink.FieldProviderID, field.TypeString, value)
+		_node.ProviderID = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(accountlink.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(accountlink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountlink.UserTable,
+			Columns: []string{accountlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_account_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MfaChallengeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   accountlink.MfaChallengeTable,
+			Columns: []string{accountlink.MfaChallengeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccountLinkCreateBulk is the builder for creating many AccountLink entities in bulk.
+type AccountLinkCreateBulk struct {
+	config
+	err      error
+	builders []*AccountLinkCreate
+}
+
+// Save creates the AccountLink entities in the database.
+func (_c *AccountLinkCreateBulk) Save(ctx context.Context) ([]*AccountLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*AccountLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *AccountLinkCreateBulk) SaveX(ctx context.Context) []*AccountLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *AccountLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *AccountLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
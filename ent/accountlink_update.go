@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// AccountLinkUpdate is the builder for updating AccountLink entities.
+type AccountLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountLinkMutation
+}
+
+// Where appends a list predicates to the AccountLinkUpdate builder.
+func (_u *AccountLinkUpdate) Where(ps ...predicate.AccountLink) *AccountLinkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountLinkUpdate) SetUpdatedAt(v time.Time) *AccountLinkUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *AccountLinkUpdate) SetAttempts(v int) *AccountLinkUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *AccountLinkUpdate) SetNillableAttempts(v *int) *AccountLinkUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *AccountLinkUpdate) AddAttempts(v int) *AccountLinkUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *AccountLinkUpdate) SetUserID(id uuid.UUID) *AccountLinkUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AccountLinkUpdate) SetUser(v *User) *AccountLinkUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID.
+func (_u *AccountLinkUpdate) SetMfaChallengeID(id uuid.UUID) *AccountLinkUpdate {
+	_u.mutation.SetMfaChallengeID(id)
+	return _u
+}
+
+// SetNillableMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID if the given value is not nil.
+func (_u *AccountLinkUpdate) SetNillableMfaChallengeID(id *uuid.UUID) *AccountLinkUpdate {
+	if id != nil {
+		_u = _u.SetMfaChallengeID(*id)
+	}
+	return _u
+}
+
+// SetMfaChallenge sets the "mfa_challenge" edge to the MFAChallenge entity.
+func (_u *AccountLinkUpdate) SetMfaChallenge(v *MFAChallenge) *AccountLinkUpdate {
+	return _u.SetMfaChallengeID(v.ID)
+}
+
+// Mutation returns the AccountLinkMutation object of the builder.
+func (_u *AccountLinkUpdate) Mutation() *AccountLinkMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AccountLinkUpdate) ClearUser() *AccountLinkUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearMfaChallenge clears the "mfa_challenge" edge to the MFAChallenge entity.
+func (_u *AccountLinkUpdate) ClearMfaChallenge() *AccountLinkUpdate {
+	_u.mutation.ClearMfaChallenge()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AccountLinkUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AccountLinkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountLinkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountLinkUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountlink.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountLinkUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountLink.user"`)
+	}
+	return nil
+}
+
+func (_u *AccountLinkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountlink.Table, accountlink.Columns, sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountlink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(accountlink.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(accountlink.FieldAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountlink.UserTable,
+			Columns: []string{accountlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountlink.UserTable,
+			Columns: []string{accountlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MfaChallengeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   accountlink.MfaChallengeTable,
+			Columns: []string{accountlink.MfaChallengeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MfaChallengeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   accountlink.MfaChallengeTable,
+			Columns: []string{accountlink.MfaChallengeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AccountLinkUpdateOne is the builder for updating a single AccountLink entity.
+type AccountLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountLinkMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AccountLinkUpdateOne) SetUpdatedAt(v time.Time) *AccountLinkUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *AccountLinkUpdateOne) SetAttempts(v int) *AccountLinkUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *AccountLinkUpdateOne) SetNillableAttempts(v *int) *AccountLinkUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *AccountLinkUpdateOne) AddAttempts(v int) *AccountLinkUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *AccountLinkUpdateOne) SetUserID(id uuid.UUID) *AccountLinkUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *AccountLinkUpdateOne) SetUser(v *User) *AccountLinkUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID.
+func (_u *AccountLinkUpdateOne) SetMfaChallengeID(id uuid.UUID) *AccountLinkUpdateOne {
+	_u.mutation.SetMfaChallengeID(id)
+	return _u
+}
+
+// SetNillableMfaChallengeID sets the "mfa_challenge" edge to the MFAChallenge entity by ID if the given value is not nil.
+func (_u *AccountLinkUpdateOne) SetNillableMfaChallengeID(id *uuid.UUID) *AccountLinkUpdateOne {
+	if id != nil {
+		_u = _u.SetMfaChallengeID(*id)
+	}
+	return _u
+}
+
+// SetMfaChallenge sets the "mfa_challenge" edge to the MFAChallenge entity.
+func (_u *AccountLinkUpdateOne) SetMfaChallenge(v *MFAChallenge) *AccountLinkUpdateOne {
+	return _u.SetMfaChallengeID(v.ID)
+}
+
+// Mutation returns the AccountLinkMutation object of the builder.
+func (_u *AccountLinkUpdateOne) Mutation() *AccountLinkMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *AccountLinkUpdateOne) ClearUser() *AccountLinkUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearMfaChallenge clears the "mfa_challenge" edge to the MFAChallenge entity.
+func (_u *AccountLinkUpdateOne) ClearMfaChallenge() *AccountLinkUpdateOne {
+	_u.mutation.ClearMfaChallenge()
+	return _u
+}
+
+// Where appends a list predicates to the AccountLinkUpdate builder.
+func (_u *AccountLinkUpdateOne) Where(ps ...predicate.AccountLink) *AccountLinkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AccountLinkUpdateOne) Select(field string, fields ...string) *AccountLinkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AccountLink entity.
+func (_u *AccountLinkUpdateOne) Save(ctx context.Context) (*AccountLink, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AccountLinkUpdateOne) SaveX(ctx context.Context) *AccountLink {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AccountLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AccountLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AccountLinkUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := accountlink.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AccountLinkUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "AccountLink.user"`)
+	}
+	return nil
+}
+
+func (_u *AccountLinkUpdateOne) sqlSave(ctx context.Context) (_node *AccountLink, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(accountlink.Table, accountlink.Columns, sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountLink.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accountlink.FieldID)
+		for _, f := range fields {
+			if !accountlink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accountlink.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(accountlink.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(accountlink.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(accountlink.FieldAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountlink.UserTable,
+			Columns: []string{accountlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accountlink.UserTable,
+			Columns: []string{accountlink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MfaChallengeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   accountlink.MfaChallengeTable,
+			Columns: []string{accountlink.MfaChallengeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MfaChallengeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   accountlink.MfaChallengeTable,
+			Columns: []string{accountlink.MfaChallengeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccountLink{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accountlink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NikSchaefer/go-fiber/ent/contactchange"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// ContactChange is the model entity for the ContactChange schema.
+type ContactChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Type holds the value of the "type" field.
+	Type contactchange.Type `json:"type,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// PreviousValue holds the value of the "previous_value" field.
+	PreviousValue string `json:"previous_value,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// RevertTokenHash holds the value of the "revert_token_hash" field.
+	RevertTokenHash *string `json:"-"`
+	// RevertExpiresAt holds the value of the "revert_expires_at" field.
+	RevertExpiresAt *time.Time `json:"revert_expires_at,omitempty"`
+	// ConfirmedAt holds the value of the "confirmed_at" field.
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ContactChangeQuery when eager-loading is set.
+	Edges                ContactChangeEdges `json:"edges"`
+	user_contact_changes *uuid.UUID
+	selectValues         sql.SelectValues
+}
+
+// ContactChangeEdges holds the relations/edges for other nodes in the graph.
+type ContactChangeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ContactChangeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ContactChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case contactchange.FieldType, contactchange.FieldValue, contactchange.FieldPreviousValue, contactchange.FieldRevertTokenHash:
+			values[i] = new(sql.NullString)
+		case contactchange.FieldCreatedAt, contactchange.FieldUpdatedAt, contactchange.FieldExpiresAt, contactchange.FieldRevertExpiresAt, contactchange.FieldConfirmedAt:
+			values[i] = new(sql.NullTime)
+		case contactchange.FieldID:
+			values[i] = new(uuid.UUID)
+		case contactchange.ForeignKeys[0]: // user_contact_changes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ContactChange fields.
+func (_m *ContactChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case contactchange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case contactchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case contactchange.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case contactchange.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = contactchange.Type(value.String)
+			}
+		case contactchange.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case contactchange.FieldPreviousValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_value", values[i])
+			} else if value.Valid {
+				_m.PreviousValue = value.String
+			}
+		case contactchange.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case contactchange.FieldRevertTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field revert_token_hash", values[i])
+			} else if value.Valid {
+				_m.RevertTokenHash = new(string)
+				*_m.RevertTokenHash = value.String
+			}
+		case contactchange.FieldRevertExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field revert_expires_at", values[i])
+			} else if value.Valid {
+				_m.RevertExpiresAt = new(time.Time)
+				*_m.RevertExpiresAt = value.Time
+			}
+		case contactchange.FieldConfirmedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field confirmed_at", values[i])
+			} else if value.Valid {
+				_m.ConfirmedAt = new(time.Time)
+				*_m.ConfirmedAt = value.Time
+			}
+		case contactchange.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_contact_changes", values[i])
+			} else if value.Valid {
+				_m.user_contact_changes = new(uuid.UUID)
+				*_m.user_contact_changes = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the ContactChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *ContactChange) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the ContactChange entity.
+func (_m *ContactChange) QueryUser() *UserQuery {
+	return NewContactChangeClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this ContactChange.
+// Note that you need to call ContactChange.Unwrap() before calling this method if this ContactChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ContactChange) Update() *ContactChangeUpdateOne {
+	return NewContactChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ContactChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ContactChange) Unwrap() *ContactChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ContactChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ContactChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("ContactChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteString(", ")
+	builder.WriteString("previous_value=")
+	builder.WriteString(_m.PreviousValue)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revert_token_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.RevertExpiresAt; v != nil {
+		builder.WriteString("revert_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ConfirmedAt; v != nil {
+		builder.WriteString("confirmed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ContactChanges is a parsable slice of ContactChange.
+type ContactChanges []*ContactChange
@@ -0,0 +1,590 @@
+// Code generated by ent, DO NOT EDIT.
+
+package contactchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldValue, v))
+}
+
+// PreviousValue applies equality check predicate on the "previous_value" field. It's identical to PreviousValueEQ.
+func PreviousValue(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldPreviousValue, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RevertTokenHash applies equality check predicate on the "revert_token_hash" field. It's identical to RevertTokenHashEQ.
+func RevertTokenHash(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldRevertTokenHash, v))
+}
+
+// RevertExpiresAt applies equality check predicate on the "revert_expires_at" field. It's identical to RevertExpiresAtEQ.
+func RevertExpiresAt(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldRevertExpiresAt, v))
+}
+
+// ConfirmedAt applies equality check predicate on the "confirmed_at" field. It's identical to ConfirmedAtEQ.
+func ConfirmedAt(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldType, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContainsFold(FieldValue, v))
+}
+
+// PreviousValueEQ applies the EQ predicate on the "previous_value" field.
+func PreviousValueEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldPreviousValue, v))
+}
+
+// PreviousValueNEQ applies the NEQ predicate on the "previous_value" field.
+func PreviousValueNEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldPreviousValue, v))
+}
+
+// PreviousValueIn applies the In predicate on the "previous_value" field.
+func PreviousValueIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldPreviousValue, vs...))
+}
+
+// PreviousValueNotIn applies the NotIn predicate on the "previous_value" field.
+func PreviousValueNotIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldPreviousValue, vs...))
+}
+
+// PreviousValueGT applies the GT predicate on the "previous_value" field.
+func PreviousValueGT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldPreviousValue, v))
+}
+
+// PreviousValueGTE applies the GTE predicate on the "previous_value" field.
+func PreviousValueGTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldPreviousValue, v))
+}
+
+// PreviousValueLT applies the LT predicate on the "previous_value" field.
+func PreviousValueLT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldPreviousValue, v))
+}
+
+// PreviousValueLTE applies the LTE predicate on the "previous_value" field.
+func PreviousValueLTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldPreviousValue, v))
+}
+
+// PreviousValueContains applies the Contains predicate on the "previous_value" field.
+func PreviousValueContains(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContains(FieldPreviousValue, v))
+}
+
+// PreviousValueHasPrefix applies the HasPrefix predicate on the "previous_value" field.
+func PreviousValueHasPrefix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasPrefix(FieldPreviousValue, v))
+}
+
+// PreviousValueHasSuffix applies the HasSuffix predicate on the "previous_value" field.
+func PreviousValueHasSuffix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasSuffix(FieldPreviousValue, v))
+}
+
+// PreviousValueIsNil applies the IsNil predicate on the "previous_value" field.
+func PreviousValueIsNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIsNull(FieldPreviousValue))
+}
+
+// PreviousValueNotNil applies the NotNil predicate on the "previous_value" field.
+func PreviousValueNotNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotNull(FieldPreviousValue))
+}
+
+// PreviousValueEqualFold applies the EqualFold predicate on the "previous_value" field.
+func PreviousValueEqualFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEqualFold(FieldPreviousValue, v))
+}
+
+// PreviousValueContainsFold applies the ContainsFold predicate on the "previous_value" field.
+func PreviousValueContainsFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContainsFold(FieldPreviousValue, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RevertTokenHashEQ applies the EQ predicate on the "revert_token_hash" field.
+func RevertTokenHashEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashNEQ applies the NEQ predicate on the "revert_token_hash" field.
+func RevertTokenHashNEQ(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashIn applies the In predicate on the "revert_token_hash" field.
+func RevertTokenHashIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldRevertTokenHash, vs...))
+}
+
+// RevertTokenHashNotIn applies the NotIn predicate on the "revert_token_hash" field.
+func RevertTokenHashNotIn(vs ...string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldRevertTokenHash, vs...))
+}
+
+// RevertTokenHashGT applies the GT predicate on the "revert_token_hash" field.
+func RevertTokenHashGT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashGTE applies the GTE predicate on the "revert_token_hash" field.
+func RevertTokenHashGTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashLT applies the LT predicate on the "revert_token_hash" field.
+func RevertTokenHashLT(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashLTE applies the LTE predicate on the "revert_token_hash" field.
+func RevertTokenHashLTE(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashContains applies the Contains predicate on the "revert_token_hash" field.
+func RevertTokenHashContains(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContains(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashHasPrefix applies the HasPrefix predicate on the "revert_token_hash" field.
+func RevertTokenHashHasPrefix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasPrefix(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashHasSuffix applies the HasSuffix predicate on the "revert_token_hash" field.
+func RevertTokenHashHasSuffix(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldHasSuffix(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashIsNil applies the IsNil predicate on the "revert_token_hash" field.
+func RevertTokenHashIsNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIsNull(FieldRevertTokenHash))
+}
+
+// RevertTokenHashNotNil applies the NotNil predicate on the "revert_token_hash" field.
+func RevertTokenHashNotNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotNull(FieldRevertTokenHash))
+}
+
+// RevertTokenHashEqualFold applies the EqualFold predicate on the "revert_token_hash" field.
+func RevertTokenHashEqualFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEqualFold(FieldRevertTokenHash, v))
+}
+
+// RevertTokenHashContainsFold applies the ContainsFold predicate on the "revert_token_hash" field.
+func RevertTokenHashContainsFold(v string) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldContainsFold(FieldRevertTokenHash, v))
+}
+
+// RevertExpiresAtEQ applies the EQ predicate on the "revert_expires_at" field.
+func RevertExpiresAtEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtNEQ applies the NEQ predicate on the "revert_expires_at" field.
+func RevertExpiresAtNEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtIn applies the In predicate on the "revert_expires_at" field.
+func RevertExpiresAtIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldRevertExpiresAt, vs...))
+}
+
+// RevertExpiresAtNotIn applies the NotIn predicate on the "revert_expires_at" field.
+func RevertExpiresAtNotIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldRevertExpiresAt, vs...))
+}
+
+// RevertExpiresAtGT applies the GT predicate on the "revert_expires_at" field.
+func RevertExpiresAtGT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtGTE applies the GTE predicate on the "revert_expires_at" field.
+func RevertExpiresAtGTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtLT applies the LT predicate on the "revert_expires_at" field.
+func RevertExpiresAtLT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtLTE applies the LTE predicate on the "revert_expires_at" field.
+func RevertExpiresAtLTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldRevertExpiresAt, v))
+}
+
+// RevertExpiresAtIsNil applies the IsNil predicate on the "revert_expires_at" field.
+func RevertExpiresAtIsNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIsNull(FieldRevertExpiresAt))
+}
+
+// RevertExpiresAtNotNil applies the NotNil predicate on the "revert_expires_at" field.
+func RevertExpiresAtNotNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotNull(FieldRevertExpiresAt))
+}
+
+// ConfirmedAtEQ applies the EQ predicate on the "confirmed_at" field.
+func ConfirmedAtEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtNEQ applies the NEQ predicate on the "confirmed_at" field.
+func ConfirmedAtNEQ(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNEQ(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIn applies the In predicate on the "confirmed_at" field.
+func ConfirmedAtIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtNotIn applies the NotIn predicate on the "confirmed_at" field.
+func ConfirmedAtNotIn(vs ...time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotIn(FieldConfirmedAt, vs...))
+}
+
+// ConfirmedAtGT applies the GT predicate on the "confirmed_at" field.
+func ConfirmedAtGT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtGTE applies the GTE predicate on the "confirmed_at" field.
+func ConfirmedAtGTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldGTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLT applies the LT predicate on the "confirmed_at" field.
+func ConfirmedAtLT(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLT(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtLTE applies the LTE predicate on the "confirmed_at" field.
+func ConfirmedAtLTE(v time.Time) predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldLTE(FieldConfirmedAt, v))
+}
+
+// ConfirmedAtIsNil applies the IsNil predicate on the "confirmed_at" field.
+func ConfirmedAtIsNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldIsNull(FieldConfirmedAt))
+}
+
+// ConfirmedAtNotNil applies the NotNil predicate on the "confirmed_at" field.
+func ConfirmedAtNotNil() predicate.ContactChange {
+	return predicate.ContactChange(sql.FieldNotNull(FieldConfirmedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.ContactChange {
+	return predicate.ContactChange(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.ContactChange {
+	return predicate.ContactChange(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ContactChange) predicate.ContactChange {
+	return predicate.ContactChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ContactChange) predicate.ContactChange {
+	return predicate.ContactChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ContactChange) predicate.ContactChange {
+	return predicate.ContactChange(sql.NotPredicates(p))
+}
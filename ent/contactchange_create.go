@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/contactchange"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// ContactChangeCreate is the builder for creating a ContactChange entity.
+type ContactChangeCreate struct {
+	config
+	mutation *ContactChangeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ContactChangeCreate) SetCreatedAt(v time.Time) *ContactChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableCreatedAt(v *time.Time) *ContactChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ContactChangeCreate) SetUpdatedAt(v time.Time) *ContactChangeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableUpdatedAt(v *time.Time) *ContactChangeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ContactChangeCreate) SetType(v contactchange.Type) *ContactChangeCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *ContactChangeCreate) SetValue(v string) *ContactChangeCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetPreviousValue sets the "previous_value" field.
+func (_c *ContactChangeCreate) SetPreviousValue(v string) *ContactChangeCreate {
+	_c.mutation.SetPreviousValue(v)
+	return _c
+}
+
+// SetNillablePreviousValue sets the "previous_value" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillablePreviousValue(v *string) *ContactChangeCreate {
+	if v != nil {
+		_c.SetPreviousValue(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ContactChangeCreate) SetExpiresAt(v time.Time) *ContactChangeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRevertTokenHash sets the "revert_token_hash" field.
+func (_c *ContactChangeCreate) SetRevertTokenHash(v string) *ContactChangeCreate {
+	_c.mutation.SetRevertTokenHash(v)
+	return _c
+}
+
+// SetNillableRevertTokenHash sets the "revert_token_hash" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableRevertTokenHash(v *string) *ContactChangeCreate {
+	if v != nil {
+		_c.SetRevertTokenHash(*v)
+	}
+	return _c
+}
+
+// SetRevertExpiresAt sets the "revert_expires_at" field.
+func (_c *ContactChangeCreate) SetRevertExpiresAt(v time.Time) *ContactChangeCreate {
+	_c.mutation.SetRevertExpiresAt(v)
+	return _c
+}
+
+// SetNillableRevertExpiresAt sets the "revert_expires_at" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableRevertExpiresAt(v *time.Time) *ContactChangeCreate {
+	if v != nil {
+		_c.SetRevertExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_c *ContactChangeCreate) SetConfirmedAt(v time.Time) *ContactChangeCreate {
+	_c.mutation.SetConfirmedAt(v)
+	return _c
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableConfirmedAt(v *time.Time) *ContactChangeCreate {
+	if v != nil {
+		_c.SetConfirmedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ContactChangeCreate) SetID(v uuid.UUID) *ContactChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ContactChangeCreate) SetNillableID(v *uuid.UUID) *ContactChangeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *ContactChangeCreate) SetUserID(id uuid.UUID) *ContactChangeCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ContactChangeCreate) SetUser(v *User) *ContactChangeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ContactChangeMutation object of the builder.
+func (_c *ContactChangeCreate) Mutation() *ContactChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the ContactChange in the database.
+func (_c *ContactChangeCreate) Save(ctx context.Context) (*ContactChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ContactChangeCreate) SaveX(ctx context.Context) *ContactChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContactChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContactChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ContactChangeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := contactchange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := contactchange.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := contactchange.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ContactChangeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ContactChange.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ContactChange.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ContactChange.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := contactchange.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContactChange.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "ContactChange.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := contactchange.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "ContactChange.value": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.PreviousValue(); ok {
+		if err := contactchange.PreviousValueValidator(v); err != nil {
+			return &ValidationError{Name: "previous_value", err: fmt.Errorf(`ent: validator failed for field "ContactChange.previous_value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "ContactChange.expires_at"`)}
+	}
+	if v, ok := _c.mutation.RevertTokenHash(); ok {
+		if err := contactchange.RevertTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "revert_token_hash", err: fmt.Errorf(`ent: validator failed for field "ContactChange.revert_token_hash": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ContactChange.user"`)}
+	}
+	return nil
+}
+
+func (_c *ContactChangeCreate) sqlSave(ctx context.Context) (*ContactChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ContactChangeCreate) createSpec() (*ContactChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ContactChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(contactchange.Table, sqlgraph.NewFieldSpec(contactchange.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(contactchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(contactchange.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(contactchange.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(contactchange.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.PreviousValue(); ok {
+		_spec.SetField(contactchange.FieldPreviousValue, field.TypeString, value)
+		_node.PreviousValue = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(contactchange.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RevertTokenHash(); ok {
+		_spec.SetField(contactchange.FieldRevertTokenHash, field.TypeString, value)
+		_node.RevertTokenHash = &value
+	}
+	if value, ok := _c.mutation.RevertExpiresAt(); ok {
+		_spec.SetField(contactchange.FieldRevertExpiresAt, field.TypeTime, value)
+		_node.RevertExpiresAt = &value
+	}
+	if value, ok := _c.mutation.ConfirmedAt(); ok {
+		_spec.SetField(contactchange.FieldConfirmedAt, field.TypeTime, value)
+		_node.ConfirmedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contactchange.UserTable,
+			Columns: []string{contactchange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_contact_changes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ContactChangeCreateBulk is the builder for creating many ContactChange entities in bulk.
+type ContactChangeCreateBulk struct {
+	config
+	err      error
+	builders []*ContactChangeCreate
+}
+
+// Save creates the ContactChange entities in the database.
+func (_c *ContactChangeCreateBulk) Save(ctx context.Context) ([]*ContactChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ContactChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ContactChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ContactChangeCreateBulk) SaveX(ctx context.Context) []*ContactChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ContactChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ContactChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -13,10 +13,25 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/apikey"
+	"github.com/NikSchaefer/go-fiber/ent/auditevent"
+	"github.com/NikSchaefer/go-fiber/ent/contactchange"
+	"github.com/NikSchaefer/go-fiber/ent/loginthrottle"
+	"github.com/NikSchaefer/go-fiber/ent/magiclink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
 	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/passkey"
 	"github.com/NikSchaefer/go-fiber/ent/profile"
+	"github.com/NikSchaefer/go-fiber/ent/ratelimitbucket"
+	"github.com/NikSchaefer/go-fiber/ent/recoverycode"
+	"github.com/NikSchaefer/go-fiber/ent/refreshtoken"
 	"github.com/NikSchaefer/go-fiber/ent/session"
+	"github.com/NikSchaefer/go-fiber/ent/tokenfamily"
+	"github.com/NikSchaefer/go-fiber/ent/totpcredential"
 	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			account.Table: account.ValidColumn,
-			otp.Table:     otp.ValidColumn,
-			profile.Table: profile.ValidColumn,
-			session.Table: session.ValidColumn,
-			user.Table:    user.ValidColumn,
+			apikey.Table:            apikey.ValidColumn,
+			account.Table:           account.ValidColumn,
+			accountlink.Table:       accountlink.ValidColumn,
+			auditevent.Table:        auditevent.ValidColumn,
+			contactchange.Table:     contactchange.ValidColumn,
+			loginthrottle.Table:     loginthrottle.ValidColumn,
+			mfachallenge.Table:      mfachallenge.ValidColumn,
+			magiclink.Table:         magiclink.ValidColumn,
+			oauthflow.Table:         oauthflow.ValidColumn,
+			otp.Table:               otp.ValidColumn,
+			passkey.Table:           passkey.ValidColumn,
+			profile.Table:           profile.ValidColumn,
+			ratelimitbucket.Table:   ratelimitbucket.ValidColumn,
+			recoverycode.Table:      recoverycode.ValidColumn,
+			refreshtoken.Table:      refreshtoken.ValidColumn,
+			session.Table:           session.ValidColumn,
+			totpcredential.Table:    totpcredential.ValidColumn,
+			tokenfamily.Table:       tokenfamily.ValidColumn,
+			user.Table:              user.ValidColumn,
+			webauthnchallenge.Table: webauthnchallenge.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/loginthrottle"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+)
+
+// LoginThrottleUpdate is the builder for updating LoginThrottle entities.
+type LoginThrottleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (_u *LoginThrottleUpdate) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginThrottleUpdate) SetUpdatedAt(v time.Time) *LoginThrottleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFailures sets the "failures" field.
+func (_u *LoginThrottleUpdate) SetFailures(v int) *LoginThrottleUpdate {
+	_u.mutation.ResetFailures()
+	_u.mutation.SetFailures(v)
+	return _u
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableFailures(v *int) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetFailures(*v)
+	}
+	return _u
+}
+
+// AddFailures adds value to the "failures" field.
+func (_u *LoginThrottleUpdate) AddFailures(v int) *LoginThrottleUpdate {
+	_u.mutation.AddFailures(v)
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *LoginThrottleUpdate) SetLastFailureAt(v time.Time) *LoginThrottleUpdate {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableLastFailureAt(v *time.Time) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LoginThrottleUpdate) SetLockedUntil(v time.Time) *LoginThrottleUpdate {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableLockedUntil(v *time.Time) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *LoginThrottleUpdate) ClearLockedUntil() *LoginThrottleUpdate {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetUnlockTokenHash sets the "unlock_token_hash" field.
+func (_u *LoginThrottleUpdate) SetUnlockTokenHash(v string) *LoginThrottleUpdate {
+	_u.mutation.SetUnlockTokenHash(v)
+	return _u
+}
+
+// SetNillableUnlockTokenHash sets the "unlock_token_hash" field if the given value is not nil.
+func (_u *LoginThrottleUpdate) SetNillableUnlockTokenHash(v *string) *LoginThrottleUpdate {
+	if v != nil {
+		_u.SetUnlockTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearUnlockTokenHash clears the value of the "unlock_token_hash" field.
+func (_u *LoginThrottleUpdate) ClearUnlockTokenHash() *LoginThrottleUpdate {
+	_u.mutation.ClearUnlockTokenHash()
+	return _u
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (_u *LoginThrottleUpdate) Mutation() *LoginThrottleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoginThrottleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginThrottleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoginThrottleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginThrottleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginThrottleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginthrottle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginThrottleUpdate) check() error {
+	if v, ok := _u.mutation.UnlockTokenHash(); ok {
+		if err := loginthrottle.UnlockTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "unlock_token_hash", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.unlock_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginThrottleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginthrottle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailures(); ok {
+		_spec.AddField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnlockTokenHash(); ok {
+		_spec.SetField(loginthrottle.FieldUnlockTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.UnlockTokenHashCleared() {
+		_spec.ClearField(loginthrottle.FieldUnlockTokenHash, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoginThrottleUpdateOne is the builder for updating a single LoginThrottle entity.
+type LoginThrottleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoginThrottleMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoginThrottleUpdateOne) SetUpdatedAt(v time.Time) *LoginThrottleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFailures sets the "failures" field.
+func (_u *LoginThrottleUpdateOne) SetFailures(v int) *LoginThrottleUpdateOne {
+	_u.mutation.ResetFailures()
+	_u.mutation.SetFailures(v)
+	return _u
+}
+
+// SetNillableFailures sets the "failures" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableFailures(v *int) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetFailures(*v)
+	}
+	return _u
+}
+
+// AddFailures adds value to the "failures" field.
+func (_u *LoginThrottleUpdateOne) AddFailures(v int) *LoginThrottleUpdateOne {
+	_u.mutation.AddFailures(v)
+	return _u
+}
+
+// SetLastFailureAt sets the "last_failure_at" field.
+func (_u *LoginThrottleUpdateOne) SetLastFailureAt(v time.Time) *LoginThrottleUpdateOne {
+	_u.mutation.SetLastFailureAt(v)
+	return _u
+}
+
+// SetNillableLastFailureAt sets the "last_failure_at" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableLastFailureAt(v *time.Time) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetLastFailureAt(*v)
+	}
+	return _u
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (_u *LoginThrottleUpdateOne) SetLockedUntil(v time.Time) *LoginThrottleUpdateOne {
+	_u.mutation.SetLockedUntil(v)
+	return _u
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableLockedUntil(v *time.Time) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetLockedUntil(*v)
+	}
+	return _u
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (_u *LoginThrottleUpdateOne) ClearLockedUntil() *LoginThrottleUpdateOne {
+	_u.mutation.ClearLockedUntil()
+	return _u
+}
+
+// SetUnlockTokenHash sets the "unlock_token_hash" field.
+func (_u *LoginThrottleUpdateOne) SetUnlockTokenHash(v string) *LoginThrottleUpdateOne {
+	_u.mutation.SetUnlockTokenHash(v)
+	return _u
+}
+
+// SetNillableUnlockTokenHash sets the "unlock_token_hash" field if the given value is not nil.
+func (_u *LoginThrottleUpdateOne) SetNillableUnlockTokenHash(v *string) *LoginThrottleUpdateOne {
+	if v != nil {
+		_u.SetUnlockTokenHash(*v)
+	}
+	return _u
+}
+
+// ClearUnlockTokenHash clears the value of the "unlock_token_hash" field.
+func (_u *LoginThrottleUpdateOne) ClearUnlockTokenHash() *LoginThrottleUpdateOne {
+	_u.mutation.ClearUnlockTokenHash()
+	return _u
+}
+
+// Mutation returns the LoginThrottleMutation object of the builder.
+func (_u *LoginThrottleUpdateOne) Mutation() *LoginThrottleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LoginThrottleUpdate builder.
+func (_u *LoginThrottleUpdateOne) Where(ps ...predicate.LoginThrottle) *LoginThrottleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoginThrottleUpdateOne) Select(field string, fields ...string) *LoginThrottleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LoginThrottle entity.
+func (_u *LoginThrottleUpdateOne) Save(ctx context.Context) (*LoginThrottle, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoginThrottleUpdateOne) SaveX(ctx context.Context) *LoginThrottle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoginThrottleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoginThrottleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoginThrottleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loginthrottle.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoginThrottleUpdateOne) check() error {
+	if v, ok := _u.mutation.UnlockTokenHash(); ok {
+		if err := loginthrottle.UnlockTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "unlock_token_hash", err: fmt.Errorf(`ent: validator failed for field "LoginThrottle.unlock_token_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LoginThrottleUpdateOne) sqlSave(ctx context.Context) (_node *LoginThrottle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loginthrottle.Table, loginthrottle.Columns, sqlgraph.NewFieldSpec(loginthrottle.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LoginThrottle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loginthrottle.FieldID)
+		for _, f := range fields {
+			if !loginthrottle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loginthrottle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loginthrottle.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Failures(); ok {
+		_spec.SetField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailures(); ok {
+		_spec.AddField(loginthrottle.FieldFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFailureAt(); ok {
+		_spec.SetField(loginthrottle.FieldLastFailureAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.LockedUntil(); ok {
+		_spec.SetField(loginthrottle.FieldLockedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.LockedUntilCleared() {
+		_spec.ClearField(loginthrottle.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnlockTokenHash(); ok {
+		_spec.SetField(loginthrottle.FieldUnlockTokenHash, field.TypeString, value)
+	}
+	if _u.mutation.UnlockTokenHashCleared() {
+		_spec.ClearField(loginthrottle.FieldUnlockTokenHash, field.TypeString)
+	}
+	_node = &LoginThrottle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loginthrottle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
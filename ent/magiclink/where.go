@@ -0,0 +1,485 @@
+// Code generated by ent, DO NOT EDIT.
+
+package magiclink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TokenHash applies equality check predicate on the "token_hash" field. It's identical to TokenHashEQ.
+func TokenHash(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// PollTokenHash applies equality check predicate on the "poll_token_hash" field. It's identical to PollTokenHashEQ.
+func PollTokenHash(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// RedirectTo applies equality check predicate on the "redirect_to" field. It's identical to RedirectToEQ.
+func RedirectTo(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldRedirectTo, v))
+}
+
+// SameDevice applies equality check predicate on the "same_device" field. It's identical to SameDeviceEQ.
+func SameDevice(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldSameDevice, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TokenHashEQ applies the EQ predicate on the "token_hash" field.
+func TokenHashEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldTokenHash, v))
+}
+
+// TokenHashNEQ applies the NEQ predicate on the "token_hash" field.
+func TokenHashNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldTokenHash, v))
+}
+
+// TokenHashIn applies the In predicate on the "token_hash" field.
+func TokenHashIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldTokenHash, vs...))
+}
+
+// TokenHashNotIn applies the NotIn predicate on the "token_hash" field.
+func TokenHashNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldTokenHash, vs...))
+}
+
+// TokenHashGT applies the GT predicate on the "token_hash" field.
+func TokenHashGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldTokenHash, v))
+}
+
+// TokenHashGTE applies the GTE predicate on the "token_hash" field.
+func TokenHashGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldTokenHash, v))
+}
+
+// TokenHashLT applies the LT predicate on the "token_hash" field.
+func TokenHashLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldTokenHash, v))
+}
+
+// TokenHashLTE applies the LTE predicate on the "token_hash" field.
+func TokenHashLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldTokenHash, v))
+}
+
+// TokenHashContains applies the Contains predicate on the "token_hash" field.
+func TokenHashContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldTokenHash, v))
+}
+
+// TokenHashHasPrefix applies the HasPrefix predicate on the "token_hash" field.
+func TokenHashHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldTokenHash, v))
+}
+
+// TokenHashHasSuffix applies the HasSuffix predicate on the "token_hash" field.
+func TokenHashHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldTokenHash, v))
+}
+
+// TokenHashEqualFold applies the EqualFold predicate on the "token_hash" field.
+func TokenHashEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldTokenHash, v))
+}
+
+// TokenHashContainsFold applies the ContainsFold predicate on the "token_hash" field.
+func TokenHashContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldTokenHash, v))
+}
+
+// PollTokenHashEQ applies the EQ predicate on the "poll_token_hash" field.
+func PollTokenHashEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashNEQ applies the NEQ predicate on the "poll_token_hash" field.
+func PollTokenHashNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldPollTokenHash, v))
+}
+
+// PollTokenHashIn applies the In predicate on the "poll_token_hash" field.
+func PollTokenHashIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashNotIn applies the NotIn predicate on the "poll_token_hash" field.
+func PollTokenHashNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldPollTokenHash, vs...))
+}
+
+// PollTokenHashGT applies the GT predicate on the "poll_token_hash" field.
+func PollTokenHashGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashGTE applies the GTE predicate on the "poll_token_hash" field.
+func PollTokenHashGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLT applies the LT predicate on the "poll_token_hash" field.
+func PollTokenHashLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldPollTokenHash, v))
+}
+
+// PollTokenHashLTE applies the LTE predicate on the "poll_token_hash" field.
+func PollTokenHashLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContains applies the Contains predicate on the "poll_token_hash" field.
+func PollTokenHashContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasPrefix applies the HasPrefix predicate on the "poll_token_hash" field.
+func PollTokenHashHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashHasSuffix applies the HasSuffix predicate on the "poll_token_hash" field.
+func PollTokenHashHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldPollTokenHash, v))
+}
+
+// PollTokenHashEqualFold applies the EqualFold predicate on the "poll_token_hash" field.
+func PollTokenHashEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldPollTokenHash, v))
+}
+
+// PollTokenHashContainsFold applies the ContainsFold predicate on the "poll_token_hash" field.
+func PollTokenHashContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldPollTokenHash, v))
+}
+
+// RedirectToEQ applies the EQ predicate on the "redirect_to" field.
+func RedirectToEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldRedirectTo, v))
+}
+
+// RedirectToNEQ applies the NEQ predicate on the "redirect_to" field.
+func RedirectToNEQ(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldRedirectTo, v))
+}
+
+// RedirectToIn applies the In predicate on the "redirect_to" field.
+func RedirectToIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldRedirectTo, vs...))
+}
+
+// RedirectToNotIn applies the NotIn predicate on the "redirect_to" field.
+func RedirectToNotIn(vs ...string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldRedirectTo, vs...))
+}
+
+// RedirectToGT applies the GT predicate on the "redirect_to" field.
+func RedirectToGT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldRedirectTo, v))
+}
+
+// RedirectToGTE applies the GTE predicate on the "redirect_to" field.
+func RedirectToGTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldRedirectTo, v))
+}
+
+// RedirectToLT applies the LT predicate on the "redirect_to" field.
+func RedirectToLT(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldRedirectTo, v))
+}
+
+// RedirectToLTE applies the LTE predicate on the "redirect_to" field.
+func RedirectToLTE(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldRedirectTo, v))
+}
+
+// RedirectToContains applies the Contains predicate on the "redirect_to" field.
+func RedirectToContains(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContains(FieldRedirectTo, v))
+}
+
+// RedirectToHasPrefix applies the HasPrefix predicate on the "redirect_to" field.
+func RedirectToHasPrefix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasPrefix(FieldRedirectTo, v))
+}
+
+// RedirectToHasSuffix applies the HasSuffix predicate on the "redirect_to" field.
+func RedirectToHasSuffix(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldHasSuffix(FieldRedirectTo, v))
+}
+
+// RedirectToIsNil applies the IsNil predicate on the "redirect_to" field.
+func RedirectToIsNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIsNull(FieldRedirectTo))
+}
+
+// RedirectToNotNil applies the NotNil predicate on the "redirect_to" field.
+func RedirectToNotNil() predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotNull(FieldRedirectTo))
+}
+
+// RedirectToEqualFold applies the EqualFold predicate on the "redirect_to" field.
+func RedirectToEqualFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEqualFold(FieldRedirectTo, v))
+}
+
+// RedirectToContainsFold applies the ContainsFold predicate on the "redirect_to" field.
+func RedirectToContainsFold(v string) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldContainsFold(FieldRedirectTo, v))
+}
+
+// SameDeviceEQ applies the EQ predicate on the "same_device" field.
+func SameDeviceEQ(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldSameDevice, v))
+}
+
+// SameDeviceNEQ applies the NEQ predicate on the "same_device" field.
+func SameDeviceNEQ(v bool) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldSameDevice, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.MagicLink {
+	return predicate.MagicLink(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.MagicLink {
+	return predicate.MagicLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.MagicLink {
+	return predicate.MagicLink(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MagicLink) predicate.MagicLink {
+	return predicate.MagicLink(sql.NotPredicates(p))
+}
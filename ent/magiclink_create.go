@@ -0,0 +1,422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/magiclink"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// MagicLinkCreate is the builder for creating a MagicLink entity.
+type MagicLinkCreate struct {
+	config
+	mutation *MagicLinkMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MagicLinkCreate) SetCreatedAt(v time.Time) *MagicLinkCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableCreatedAt(v *time.Time) *MagicLinkCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MagicLinkCreate) SetUpdatedAt(v time.Time) *MagicLinkCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableUpdatedAt(v *time.Time) *MagicLinkCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *MagicLinkCreate) SetTokenHash(v string) *MagicLinkCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetPollTokenHash sets the "poll_token_hash" field.
+func (_c *MagicLinkCreate) SetPollTokenHash(v string) *MagicLinkCreate {
+	_c.mutation.SetPollTokenHash(v)
+	return _c
+}
+
+// SetRedirectTo sets the "redirect_to" field.
+func (_c *MagicLinkCreate) SetRedirectTo(v string) *MagicLinkCreate {
+	_c.mutation.SetRedirectTo(v)
+	return _c
+}
+
+// SetNillableRedirectTo sets the "redirect_to" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableRedirectTo(v *string) *MagicLinkCreate {
+	if v != nil {
+		_c.SetRedirectTo(*v)
+	}
+	return _c
+}
+
+// SetSameDevice sets the "same_device" field.
+func (_c *MagicLinkCreate) SetSameDevice(v bool) *MagicLinkCreate {
+	_c.mutation.SetSameDevice(v)
+	return _c
+}
+
+// SetNillableSameDevice sets the "same_device" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableSameDevice(v *bool) *MagicLinkCreate {
+	if v != nil {
+		_c.SetSameDevice(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *MagicLinkCreate) SetStatus(v magiclink.Status) *MagicLinkCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableStatus(v *magiclink.Status) *MagicLinkCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MagicLinkCreate) SetExpiresAt(v time.Time) *MagicLinkCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableExpiresAt(v *time.Time) *MagicLinkCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MagicLinkCreate) SetID(v uuid.UUID) *MagicLinkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MagicLinkCreate) SetNillableID(v *uuid.UUID) *MagicLinkCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *MagicLinkCreate) SetUserID(id uuid.UUID) *MagicLinkCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MagicLinkCreate) SetUser(v *User) *MagicLinkCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the MagicLinkMutation object of the builder.
+func (_c *MagicLinkCreate) Mutation() *MagicLinkMutation {
+	return _c.mutation
+}
+
+// Save creates the MagicLink in the database.
+func (_c *MagicLinkCreate) Save(ctx context.Context) (*MagicLink, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MagicLinkCreate) SaveX(ctx context.Context) *MagicLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MagicLinkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MagicLinkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MagicLinkCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := magiclink.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := magiclink.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.SameDevice(); !ok {
+		v := magiclink.DefaultSameDevice
+		_c.mutation.SetSameDevice(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := magiclink.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := magiclink.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := magiclink.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MagicLinkCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MagicLink.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MagicLink.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "MagicLink.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := magiclink.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "MagicLink.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PollTokenHash(); !ok {
+		return &ValidationError{Name: "poll_token_hash", err: errors.New(`ent: missing required field "MagicLink.poll_token_hash"`)}
+	}
+	if v, ok := _c.mutation.PollTokenHash(); ok {
+		if err := magiclink.PollTokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "poll_token_hash", err: fmt.Errorf(`ent: validator failed for field "MagicLink.poll_token_hash": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.RedirectTo(); ok {
+		if err := magiclink.RedirectToValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_to", err: fmt.Errorf(`ent: validator failed for field "MagicLink.redirect_to": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SameDevice(); !ok {
+		return &ValidationError{Name: "same_device", err: errors.New(`ent: missing required field "MagicLink.same_device"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "MagicLink.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := magiclink.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "MagicLink.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "MagicLink.expires_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MagicLink.user"`)}
+	}
+	return nil
+}
+
+func (_c *MagicLinkCreate) sqlSave(ctx context.Context) (*MagicLink, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MagicLinkCreate) createSpec() (*MagicLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MagicLink{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(magiclink.Table, sqlgraph.NewFieldSpec(magiclink.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(magiclink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(magiclink.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(magiclink.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.PollTokenHash(); ok {
+		_spec.SetField(magiclink.FieldPollTokenHash, field.TypeString, value)
+		_node.PollTokenHash = value
+	}
+	if value, ok := _c.mutation.RedirectTo(); ok {
+		_spec.SetField(magiclink.FieldRedirectTo, field.TypeString, value)
+		_node.RedirectTo = value
+	}
+	if value, ok := _c.mutation.SameDevice(); ok {
+		_spec.SetField(magiclink.FieldSameDevice, field.TypeBool, value)
+		_node.SameDevice = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(magiclink.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(magiclink.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   magiclink.UserTable,
+			Columns: []string{magiclink.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_magic_links = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MagicLinkCreateBulk is the builder for creating many MagicLink entities in bulk.
+type MagicLinkCreateBulk struct {
+	config
+	err      error
+	builders []*MagicLinkCreate
+}
+
+// Save creates the MagicLink entities in the database.
+func (_c *MagicLinkCreateBulk) Save(ctx context.Context) ([]*MagicLink, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MagicLink, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MagicLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MagicLinkCreateBulk) SaveX(ctx context.Context) []*MagicLink {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MagicLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MagicLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// MFAChallenge is the model entity for the MFAChallenge schema.
+type MFAChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Method holds the value of the "method" field.
+	Method mfachallenge.Method `json:"method,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MFAChallengeQuery when eager-loading is set.
+	Edges                      MFAChallengeEdges `json:"edges"`
+	account_link_mfa_challenge *uuid.UUID
+	user_mfa_challenges        *uuid.UUID
+	selectValues               sql.SelectValues
+}
+
+// MFAChallengeEdges holds the relations/edges for other nodes in the graph.
+type MFAChallengeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// AccountLink holds the value of the account_link edge.
+	AccountLink *AccountLink `json:"account_link,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MFAChallengeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AccountLinkOrErr returns the AccountLink value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e MFAChallengeEdges) AccountLinkOrErr() (*AccountLink, error) {
+	if e.AccountLink != nil {
+		return e.AccountLink, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: accountlink.Label}
+	}
+	return nil, &NotLoadedError{edge: "account_link"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*MFAChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case mfachallenge.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case mfachallenge.FieldTokenHash, mfachallenge.FieldMethod:
+			values[i] = new(sql.NullString)
+		case mfachallenge.FieldCreatedAt, mfachallenge.FieldUpdatedAt, mfachallenge.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case mfachallenge.FieldID:
+			values[i] = new(uuid.UUID)
+		case mfachallenge.ForeignKeys[0]: // account_link_mfa_challenge
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case mfachallenge.ForeignKeys[1]: // user_mfa_challenges
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the MFAChallenge fields.
+func (_m *MFAChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case mfachallenge.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case mfachallenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case mfachallenge.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case mfachallenge.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				_m.TokenHash = value.String
+			}
+		case mfachallenge.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = mfachallenge.Method(value.String)
+			}
+		case mfachallenge.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case mfachallenge.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case mfachallenge.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field account_link_mfa_challenge", values[i])
+			} else if value.Valid {
+				_m.account_link_mfa_challenge = new(uuid.UUID)
+				*_m.account_link_mfa_challenge = *value.S.(*uuid.UUID)
+			}
+		case mfachallenge.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_mfa_challenges", values[i])
+			} else if value.Valid {
+				_m.user_mfa_challenges = new(uuid.UUID)
+				*_m.user_mfa_challenges = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the MFAChallenge.
+// This includes values selected through modifiers, order, etc.
+func (_m *MFAChallenge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the MFAChallenge entity.
+func (_m *MFAChallenge) QueryUser() *UserQuery {
+	return NewMFAChallengeClient(_m.config).QueryUser(_m)
+}
+
+// QueryAccountLink queries the "account_link" edge of the MFAChallenge entity.
+func (_m *MFAChallenge) QueryAccountLink() *AccountLinkQuery {
+	return NewMFAChallengeClient(_m.config).QueryAccountLink(_m)
+}
+
+// Update returns a builder for updating this MFAChallenge.
+// Note that you need to call MFAChallenge.Unwrap() before calling this method if this MFAChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *MFAChallenge) Update() *MFAChallengeUpdateOne {
+	return NewMFAChallengeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the MFAChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *MFAChallenge) Unwrap() *MFAChallenge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: MFAChallenge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *MFAChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("MFAChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Method))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// MFAChallenges is a parsable slice of MFAChallenge.
+type MFAChallenges []*MFAChallenge
@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// MFAChallengeCreate is the builder for creating a MFAChallenge entity.
+type MFAChallengeCreate struct {
+	config
+	mutation *MFAChallengeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MFAChallengeCreate) SetCreatedAt(v time.Time) *MFAChallengeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableCreatedAt(v *time.Time) *MFAChallengeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MFAChallengeCreate) SetUpdatedAt(v time.Time) *MFAChallengeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableUpdatedAt(v *time.Time) *MFAChallengeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (_c *MFAChallengeCreate) SetTokenHash(v string) *MFAChallengeCreate {
+	_c.mutation.SetTokenHash(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *MFAChallengeCreate) SetMethod(v mfachallenge.Method) *MFAChallengeCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *MFAChallengeCreate) SetAttempts(v int) *MFAChallengeCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableAttempts(v *int) *MFAChallengeCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *MFAChallengeCreate) SetExpiresAt(v time.Time) *MFAChallengeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableExpiresAt(v *time.Time) *MFAChallengeCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MFAChallengeCreate) SetID(v uuid.UUID) *MFAChallengeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableID(v *uuid.UUID) *MFAChallengeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *MFAChallengeCreate) SetUserID(id uuid.UUID) *MFAChallengeCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *MFAChallengeCreate) SetUser(v *User) *MFAChallengeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAccountLinkID sets the "account_link" edge to the AccountLink entity by ID.
+func (_c *MFAChallengeCreate) SetAccountLinkID(id uuid.UUID) *MFAChallengeCreate {
+	_c.mutation.SetAccountLinkID(id)
+	return _c
+}
+
+// SetNillableAccountLinkID sets the "account_link" edge to the AccountLink entity by ID if the given value is not nil.
+func (_c *MFAChallengeCreate) SetNillableAccountLinkID(id *uuid.UUID) *MFAChallengeCreate {
+	if id != nil {
+		_c = _c.SetAccountLinkID(*id)
+	}
+	return _c
+}
+
+// SetAccountLink sets the "account_link" edge to the AccountLink entity.
+func (_c *MFAChallengeCreate) SetAccountLink(v *AccountLink) *MFAChallengeCreate {
+	return _c.SetAccountLinkID(v.ID)
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (_c *MFAChallengeCreate) Mutation() *MFAChallengeMutation {
+	return _c.mutation
+}
+
+// Save creates the MFAChallenge in the database.
+func (_c *MFAChallengeCreate) Save(ctx context.Context) (*MFAChallenge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MFAChallengeCreate) SaveX(ctx context.Context) *MFAChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MFAChallengeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MFAChallengeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MFAChallengeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := mfachallenge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := mfachallenge.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := mfachallenge.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := mfachallenge.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := mfachallenge.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MFAChallengeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "MFAChallenge.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "MFAChallenge.updated_at"`)}
+	}
+	if _, ok := _c.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "MFAChallenge.token_hash"`)}
+	}
+	if v, ok := _c.mutation.TokenHash(); ok {
+		if err := mfachallenge.TokenHashValidator(v); err != nil {
+			return &ValidationError{Name: "token_hash", err: fmt.Errorf(`ent: validator failed for field "MFAChallenge.token_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "MFAChallenge.method"`)}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := mfachallenge.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "MFAChallenge.method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "MFAChallenge.attempts"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "MFAChallenge.expires_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "MFAChallenge.user"`)}
+	}
+	return nil
+}
+
+func (_c *MFAChallengeCreate) sqlSave(ctx context.Context) (*MFAChallenge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MFAChallengeCreate) createSpec() (*MFAChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MFAChallenge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(mfachallenge.Table, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(mfachallenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfachallenge.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.TokenHash(); ok {
+		_spec.SetField(mfachallenge.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(mfachallenge.FieldMethod, field.TypeEnum, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(mfachallenge.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_mfa_challenges = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AccountLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   mfachallenge.AccountLinkTable,
+			Columns: []string{mfachallenge.AccountLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.account_link_mfa_challenge = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MFAChallengeCreateBulk is the builder for creating many MFAChallenge entities in bulk.
+type MFAChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*MFAChallengeCreate
+}
+
+// Save creates the MFAChallenge entities in the database.
+func (_c *MFAChallengeCreateBulk) Save(ctx context.Context) ([]*MFAChallenge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MFAChallenge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MFAChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MFAChallengeCreateBulk) SaveX(ctx context.Context) []*MFAChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MFAChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MFAChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
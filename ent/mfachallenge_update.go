@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// MFAChallengeUpdate is the builder for updating MFAChallenge entities.
+type MFAChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MFAChallengeMutation
+}
+
+// Where appends a list predicates to the MFAChallengeUpdate builder.
+func (_u *MFAChallengeUpdate) Where(ps ...predicate.MFAChallenge) *MFAChallengeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MFAChallengeUpdate) SetUpdatedAt(v time.Time) *MFAChallengeUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MFAChallengeUpdate) SetAttempts(v int) *MFAChallengeUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MFAChallengeUpdate) SetNillableAttempts(v *int) *MFAChallengeUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MFAChallengeUpdate) AddAttempts(v int) *MFAChallengeUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *MFAChallengeUpdate) SetUserID(id uuid.UUID) *MFAChallengeUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MFAChallengeUpdate) SetUser(v *User) *MFAChallengeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAccountLinkID sets the "account_link" edge to the AccountLink entity by ID.
+func (_u *MFAChallengeUpdate) SetAccountLinkID(id uuid.UUID) *MFAChallengeUpdate {
+	_u.mutation.SetAccountLinkID(id)
+	return _u
+}
+
+// SetNillableAccountLinkID sets the "account_link" edge to the AccountLink entity by ID if the given value is not nil.
+func (_u *MFAChallengeUpdate) SetNillableAccountLinkID(id *uuid.UUID) *MFAChallengeUpdate {
+	if id != nil {
+		_u = _u.SetAccountLinkID(*id)
+	}
+	return _u
+}
+
+// SetAccountLink sets the "account_link" edge to the AccountLink entity.
+func (_u *MFAChallengeUpdate) SetAccountLink(v *AccountLink) *MFAChallengeUpdate {
+	return _u.SetAccountLinkID(v.ID)
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (_u *MFAChallengeUpdate) Mutation() *MFAChallengeMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MFAChallengeUpdate) ClearUser() *MFAChallengeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAccountLink clears the "account_link" edge to the AccountLink entity.
+func (_u *MFAChallengeUpdate) ClearAccountLink() *MFAChallengeUpdate {
+	_u.mutation.ClearAccountLink()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MFAChallengeUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MFAChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MFAChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MFAChallengeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MFAChallengeUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := mfachallenge.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MFAChallengeUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MFAChallenge.user"`)
+	}
+	return nil
+}
+
+func (_u *MFAChallengeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfachallenge.Table, mfachallenge.Columns, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfachallenge.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountLinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   mfachallenge.AccountLinkTable,
+			Columns: []string{mfachallenge.AccountLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   mfachallenge.AccountLinkTable,
+			Columns: []string{mfachallenge.AccountLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfachallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MFAChallengeUpdateOne is the builder for updating a single MFAChallenge entity.
+type MFAChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MFAChallengeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MFAChallengeUpdateOne) SetUpdatedAt(v time.Time) *MFAChallengeUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *MFAChallengeUpdateOne) SetAttempts(v int) *MFAChallengeUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *MFAChallengeUpdateOne) SetNillableAttempts(v *int) *MFAChallengeUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *MFAChallengeUpdateOne) AddAttempts(v int) *MFAChallengeUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *MFAChallengeUpdateOne) SetUserID(id uuid.UUID) *MFAChallengeUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *MFAChallengeUpdateOne) SetUser(v *User) *MFAChallengeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAccountLinkID sets the "account_link" edge to the AccountLink entity by ID.
+func (_u *MFAChallengeUpdateOne) SetAccountLinkID(id uuid.UUID) *MFAChallengeUpdateOne {
+	_u.mutation.SetAccountLinkID(id)
+	return _u
+}
+
+// SetNillableAccountLinkID sets the "account_link" edge to the AccountLink entity by ID if the given value is not nil.
+func (_u *MFAChallengeUpdateOne) SetNillableAccountLinkID(id *uuid.UUID) *MFAChallengeUpdateOne {
+	if id != nil {
+		_u = _u.SetAccountLinkID(*id)
+	}
+	return _u
+}
+
+// SetAccountLink sets the "account_link" edge to the AccountLink entity.
+func (_u *MFAChallengeUpdateOne) SetAccountLink(v *AccountLink) *MFAChallengeUpdateOne {
+	return _u.SetAccountLinkID(v.ID)
+}
+
+// Mutation returns the MFAChallengeMutation object of the builder.
+func (_u *MFAChallengeUpdateOne) Mutation() *MFAChallengeMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *MFAChallengeUpdateOne) ClearUser() *MFAChallengeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearAccountLink clears the "account_link" edge to the AccountLink entity.
+func (_u *MFAChallengeUpdateOne) ClearAccountLink() *MFAChallengeUpdateOne {
+	_u.mutation.ClearAccountLink()
+	return _u
+}
+
+// Where appends a list predicates to the MFAChallengeUpdate builder.
+func (_u *MFAChallengeUpdateOne) Where(ps ...predicate.MFAChallenge) *MFAChallengeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MFAChallengeUpdateOne) Select(field string, fields ...string) *MFAChallengeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MFAChallenge entity.
+func (_u *MFAChallengeUpdateOne) Save(ctx context.Context) (*MFAChallenge, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MFAChallengeUpdateOne) SaveX(ctx context.Context) *MFAChallenge {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MFAChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MFAChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *MFAChallengeUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := mfachallenge.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MFAChallengeUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "MFAChallenge.user"`)
+	}
+	return nil
+}
+
+func (_u *MFAChallengeUpdateOne) sqlSave(ctx context.Context) (_node *MFAChallenge, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(mfachallenge.Table, mfachallenge.Columns, sqlgraph.NewFieldSpec(mfachallenge.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MFAChallenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, mfachallenge.FieldID)
+		for _, f := range fields {
+			if !mfachallenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != mfachallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(mfachallenge.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(mfachallenge.FieldAttempts, field.TypeInt, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   mfachallenge.UserTable,
+			Columns: []string{mfachallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AccountLinkCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   mfachallenge.AccountLinkTable,
+			Columns: []string{mfachallenge.AccountLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountLinkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   mfachallenge.AccountLinkTable,
+			Columns: []string{mfachallenge.AccountLinkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(accountlink.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &MFAChallenge{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{mfachallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,12 +8,39 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "prefix", Type: field.TypeString, Unique: true, Size: 16},
+		{Name: "secret_hash", Type: field.TypeString, Size: 64},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_api_keys", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_users_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"password", "google", "apple"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"password", "google", "apple", "github", "microsoft", "gitlab", "oidc"}},
 		{Name: "password_hash", Type: field.TypeBytes, Nullable: true, Size: 255},
 		{Name: "provider_id", Type: field.TypeString, Unique: true, Nullable: true, Size: 255},
 		{Name: "user_accounts", Type: field.TypeUUID, Nullable: true},
@@ -32,14 +59,205 @@ var (
 			},
 		},
 	}
+	// AccountLinksColumns holds the columns for the "account_links" table.
+	AccountLinksColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"google", "apple", "github", "microsoft", "gitlab", "oidc"}},
+		{Name: "provider_id", Type: field.TypeString, Size: 255},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_account_links", Type: field.TypeUUID},
+	}
+	// AccountLinksTable holds the schema information for the "account_links" table.
+	AccountLinksTable = &schema.Table{
+		Name:       "account_links",
+		Columns:    AccountLinksColumns,
+		PrimaryKey: []*schema.Column{AccountLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_links_users_account_links",
+				Columns:    []*schema.Column{AccountLinksColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"account_linked", "account_unlinked"}},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "user_audit_events", Type: field.TypeUUID},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_events_users_audit_events",
+				Columns:    []*schema.Column{AuditEventsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+		},
+	}
+	// ContactChangesColumns holds the columns for the "contact_changes" table.
+	ContactChangesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"phone", "email"}},
+		{Name: "value", Type: field.TypeString, Size: 255},
+		{Name: "previous_value", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revert_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+		{Name: "revert_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_contact_changes", Type: field.TypeUUID},
+	}
+	// ContactChangesTable holds the schema information for the "contact_changes" table.
+	ContactChangesTable = &schema.Table{
+		Name:       "contact_changes",
+		Columns:    ContactChangesColumns,
+		PrimaryKey: []*schema.Column{ContactChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "contact_changes_users_contact_changes",
+				Columns:    []*schema.Column{ContactChangesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// LoginThrottlesColumns holds the columns for the "login_throttles" table.
+	LoginThrottlesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true, Size: 320},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "last_failure_at", Type: field.TypeTime},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "unlock_token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
+	}
+	// LoginThrottlesTable holds the schema information for the "login_throttles" table.
+	LoginThrottlesTable = &schema.Table{
+		Name:       "login_throttles",
+		Columns:    LoginThrottlesColumns,
+		PrimaryKey: []*schema.Column{LoginThrottlesColumns[0]},
+	}
+	// MfaChallengesColumns holds the columns for the "mfa_challenges" table.
+	MfaChallengesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"password", "otp", "magic_link", "passkey"}},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "account_link_mfa_challenge", Type: field.TypeUUID, Unique: true, Nullable: true},
+		{Name: "user_mfa_challenges", Type: field.TypeUUID},
+	}
+	// MfaChallengesTable holds the schema information for the "mfa_challenges" table.
+	MfaChallengesTable = &schema.Table{
+		Name:       "mfa_challenges",
+		Columns:    MfaChallengesColumns,
+		PrimaryKey: []*schema.Column{MfaChallengesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "mfa_challenges_account_links_mfa_challenge",
+				Columns:    []*schema.Column{MfaChallengesColumns[7]},
+				RefColumns: []*schema.Column{AccountLinksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "mfa_challenges_users_mfa_challenges",
+				Columns:    []*schema.Column{MfaChallengesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// MagicLinksColumns holds the columns for the "magic_links" table.
+	MagicLinksColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "poll_token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "redirect_to", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "same_device", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "consumed"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_magic_links", Type: field.TypeUUID},
+	}
+	// MagicLinksTable holds the schema information for the "magic_links" table.
+	MagicLinksTable = &schema.Table{
+		Name:       "magic_links",
+		Columns:    MagicLinksColumns,
+		PrimaryKey: []*schema.Column{MagicLinksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "magic_links_users_magic_links",
+				Columns:    []*schema.Column{MagicLinksColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// OauthFlowsColumns holds the columns for the "oauth_flows" table.
+	OauthFlowsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "state_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "provider", Type: field.TypeString, Size: 32},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"login", "link"}, Default: "login"},
+		{Name: "code_verifier", Type: field.TypeString, Nullable: true, Size: 128},
+		{Name: "nonce", Type: field.TypeString, Size: 64},
+		{Name: "return_to", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_oauth_flows", Type: field.TypeUUID, Nullable: true},
+	}
+	// OauthFlowsTable holds the schema information for the "oauth_flows" table.
+	OauthFlowsTable = &schema.Table{
+		Name:       "oauth_flows",
+		Columns:    OauthFlowsColumns,
+		PrimaryKey: []*schema.Column{OauthFlowsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_flows_users_oauth_flows",
+				Columns:    []*schema.Column{OauthFlowsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// OtPsColumns holds the columns for the "ot_ps" table.
 	OtPsColumns = []*schema.Column{
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "code", Type: field.TypeString, Size: 255},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "password_reset"}, Default: "login"},
+		{Name: "code_hash", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"login", "password_reset", "reauthentication", "email_verification", "phone_verification", "email_change", "account_link"}, Default: "login"},
 		{Name: "used", Type: field.TypeBool, Default: false},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
 		{Name: "expires_at", Type: field.TypeTime},
 		{Name: "user_otps", Type: field.TypeUUID},
 	}
@@ -51,7 +269,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "ot_ps_users_otps",
-				Columns:    []*schema.Column{OtPsColumns[7]},
+				Columns:    []*schema.Column{OtPsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// PasskeysColumns holds the columns for the "passkeys" table.
+	PasskeysColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "credential_id", Type: field.TypeBytes, Unique: true},
+		{Name: "public_key", Type: field.TypeBytes},
+		{Name: "attestation_type", Type: field.TypeString, Nullable: true},
+		{Name: "transports", Type: field.TypeJSON, Nullable: true},
+		{Name: "aaguid", Type: field.TypeBytes, Nullable: true},
+		{Name: "sign_count", Type: field.TypeUint32, Default: 0},
+		{Name: "backup_eligible", Type: field.TypeBool, Default: false},
+		{Name: "backup_state", Type: field.TypeBool, Default: false},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_passkeys", Type: field.TypeUUID},
+	}
+	// PasskeysTable holds the schema information for the "passkeys" table.
+	PasskeysTable = &schema.Table{
+		Name:       "passkeys",
+		Columns:    PasskeysColumns,
+		PrimaryKey: []*schema.Column{PasskeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "passkeys_users_passkeys",
+				Columns:    []*schema.Column{PasskeysColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -64,6 +313,9 @@ var (
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString, Size: 255},
 		{Name: "birthday", Type: field.TypeTime, Nullable: true},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true, Size: 2048},
+		{Name: "avatar_sizes", Type: field.TypeJSON, Nullable: true},
+		{Name: "avatar_key", Type: field.TypeString, Nullable: true, Size: 255},
 		{Name: "user_profile", Type: field.TypeUUID, Unique: true},
 	}
 	// ProfilesTable holds the schema information for the "profiles" table.
@@ -74,7 +326,87 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "profiles_users_profile",
-				Columns:    []*schema.Column{ProfilesColumns[5]},
+				Columns:    []*schema.Column{ProfilesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RateLimitBucketsColumns holds the columns for the "rate_limit_buckets" table.
+	RateLimitBucketsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeString, Unique: true, Size: 512},
+		{Name: "value", Type: field.TypeFloat64, Default: 0},
+		{Name: "previous", Type: field.TypeFloat64, Default: 0},
+		{Name: "stamp", Type: field.TypeTime},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// RateLimitBucketsTable holds the schema information for the "rate_limit_buckets" table.
+	RateLimitBucketsTable = &schema.Table{
+		Name:       "rate_limit_buckets",
+		Columns:    RateLimitBucketsColumns,
+		PrimaryKey: []*schema.Column{RateLimitBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimitbucket_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitBucketsColumns[8]},
+			},
+		},
+	}
+	// RecoveryCodesColumns holds the columns for the "recovery_codes" table.
+	RecoveryCodesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "code_hash", Type: field.TypeString, Size: 64},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_recovery_codes", Type: field.TypeUUID},
+	}
+	// RecoveryCodesTable holds the schema information for the "recovery_codes" table.
+	RecoveryCodesTable = &schema.Table{
+		Name:       "recovery_codes",
+		Columns:    RecoveryCodesColumns,
+		PrimaryKey: []*schema.Column{RecoveryCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recovery_codes_users_recovery_codes",
+				Columns:    []*schema.Column{RecoveryCodesColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token_family_refresh_tokens", Type: field.TypeUUID},
+		{Name: "user_refresh_tokens", Type: field.TypeUUID},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_token_families_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[7]},
+				RefColumns: []*schema.Column{TokenFamiliesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[8]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -85,7 +417,15 @@ var (
 		{Name: "oid", Type: field.TypeUUID},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "token_hash", Type: field.TypeString, Unique: true, Nullable: true, Size: 64},
 		{Name: "expires", Type: field.TypeTime},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "device", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "browser", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "os", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "authenticated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_sessions", Type: field.TypeUUID},
 	}
 	// SessionsTable holds the schema information for the "sessions" table.
@@ -96,7 +436,55 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sessions_users_sessions",
-				Columns:    []*schema.Column{SessionsColumns[4]},
+				Columns:    []*schema.Column{SessionsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TotpCredentialsColumns holds the columns for the "totp_credentials" table.
+	TotpCredentialsColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "secret", Type: field.TypeString, Size: 64},
+		{Name: "confirmed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_step", Type: field.TypeInt64, Default: 0},
+		{Name: "user_totp_credential", Type: field.TypeUUID, Unique: true},
+	}
+	// TotpCredentialsTable holds the schema information for the "totp_credentials" table.
+	TotpCredentialsTable = &schema.Table{
+		Name:       "totp_credentials",
+		Columns:    TotpCredentialsColumns,
+		PrimaryKey: []*schema.Column{TotpCredentialsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "totp_credentials_users_totp_credential",
+				Columns:    []*schema.Column{TotpCredentialsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TokenFamiliesColumns holds the columns for the "token_families" table.
+	TokenFamiliesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoke_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"logout", "reuse_detected", "account_change"}},
+		{Name: "authenticated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_token_families", Type: field.TypeUUID},
+	}
+	// TokenFamiliesTable holds the schema information for the "token_families" table.
+	TokenFamiliesTable = &schema.Table{
+		Name:       "token_families",
+		Columns:    TokenFamiliesColumns,
+		PrimaryKey: []*schema.Column{TokenFamiliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "token_families_users_token_families",
+				Columns:    []*schema.Column{TokenFamiliesColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -118,19 +506,74 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebAuthnChallengesColumns holds the columns for the "web_authn_challenges" table.
+	WebAuthnChallengesColumns = []*schema.Column{
+		{Name: "oid", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "challenge", Type: field.TypeString, Unique: true},
+		{Name: "ceremony", Type: field.TypeEnum, Enums: []string{"registration", "login"}},
+		{Name: "session_data", Type: field.TypeBytes},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "user_webauthn_challenges", Type: field.TypeUUID, Nullable: true},
+	}
+	// WebAuthnChallengesTable holds the schema information for the "web_authn_challenges" table.
+	WebAuthnChallengesTable = &schema.Table{
+		Name:       "web_authn_challenges",
+		Columns:    WebAuthnChallengesColumns,
+		PrimaryKey: []*schema.Column{WebAuthnChallengesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "web_authn_challenges_users_webauthn_challenges",
+				Columns:    []*schema.Column{WebAuthnChallengesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
 		AccountsTable,
+		AccountLinksTable,
+		AuditEventsTable,
+		ContactChangesTable,
+		LoginThrottlesTable,
+		MfaChallengesTable,
+		MagicLinksTable,
+		OauthFlowsTable,
 		OtPsTable,
+		PasskeysTable,
 		ProfilesTable,
+		RateLimitBucketsTable,
+		RecoveryCodesTable,
+		RefreshTokensTable,
 		SessionsTable,
+		TotpCredentialsTable,
+		TokenFamiliesTable,
 		UsersTable,
+		WebAuthnChallengesTable,
 	}
 )
 
 func init() {
+	APIKeysTable.ForeignKeys[0].RefTable = UsersTable
 	AccountsTable.ForeignKeys[0].RefTable = UsersTable
+	AccountLinksTable.ForeignKeys[0].RefTable = UsersTable
+	AuditEventsTable.ForeignKeys[0].RefTable = UsersTable
+	ContactChangesTable.ForeignKeys[0].RefTable = UsersTable
+	MfaChallengesTable.ForeignKeys[0].RefTable = AccountLinksTable
+	MfaChallengesTable.ForeignKeys[1].RefTable = UsersTable
+	MagicLinksTable.ForeignKeys[0].RefTable = UsersTable
+	OauthFlowsTable.ForeignKeys[0].RefTable = UsersTable
 	OtPsTable.ForeignKeys[0].RefTable = UsersTable
+	PasskeysTable.ForeignKeys[0].RefTable = UsersTable
 	ProfilesTable.ForeignKeys[0].RefTable = UsersTable
+	RecoveryCodesTable.ForeignKeys[0].RefTable = UsersTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = TokenFamiliesTable
+	RefreshTokensTable.ForeignKeys[1].RefTable = UsersTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	TotpCredentialsTable.ForeignKeys[0].RefTable = UsersTable
+	TokenFamiliesTable.ForeignKeys[0].RefTable = UsersTable
+	WebAuthnChallengesTable.ForeignKeys[0].RefTable = UsersTable
 }
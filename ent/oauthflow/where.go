@@ -0,0 +1,620 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthflow
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StateHash applies equality check predicate on the "state_hash" field. It's identical to StateHashEQ.
+func StateHash(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldStateHash, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldProvider, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldNonce, v))
+}
+
+// ReturnTo applies equality check predicate on the "return_to" field. It's identical to ReturnToEQ.
+func ReturnTo(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldReturnTo, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// StateHashEQ applies the EQ predicate on the "state_hash" field.
+func StateHashEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldStateHash, v))
+}
+
+// StateHashNEQ applies the NEQ predicate on the "state_hash" field.
+func StateHashNEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldStateHash, v))
+}
+
+// StateHashIn applies the In predicate on the "state_hash" field.
+func StateHashIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldStateHash, vs...))
+}
+
+// StateHashNotIn applies the NotIn predicate on the "state_hash" field.
+func StateHashNotIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldStateHash, vs...))
+}
+
+// StateHashGT applies the GT predicate on the "state_hash" field.
+func StateHashGT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldStateHash, v))
+}
+
+// StateHashGTE applies the GTE predicate on the "state_hash" field.
+func StateHashGTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldStateHash, v))
+}
+
+// StateHashLT applies the LT predicate on the "state_hash" field.
+func StateHashLT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldStateHash, v))
+}
+
+// StateHashLTE applies the LTE predicate on the "state_hash" field.
+func StateHashLTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldStateHash, v))
+}
+
+// StateHashContains applies the Contains predicate on the "state_hash" field.
+func StateHashContains(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContains(FieldStateHash, v))
+}
+
+// StateHashHasPrefix applies the HasPrefix predicate on the "state_hash" field.
+func StateHashHasPrefix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasPrefix(FieldStateHash, v))
+}
+
+// StateHashHasSuffix applies the HasSuffix predicate on the "state_hash" field.
+func StateHashHasSuffix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasSuffix(FieldStateHash, v))
+}
+
+// StateHashEqualFold applies the EqualFold predicate on the "state_hash" field.
+func StateHashEqualFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEqualFold(FieldStateHash, v))
+}
+
+// StateHashContainsFold applies the ContainsFold predicate on the "state_hash" field.
+func StateHashContainsFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContainsFold(FieldStateHash, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// PurposeEQ applies the EQ predicate on the "purpose" field.
+func PurposeEQ(v Purpose) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldPurpose, v))
+}
+
+// PurposeNEQ applies the NEQ predicate on the "purpose" field.
+func PurposeNEQ(v Purpose) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldPurpose, v))
+}
+
+// PurposeIn applies the In predicate on the "purpose" field.
+func PurposeIn(vs ...Purpose) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldPurpose, vs...))
+}
+
+// PurposeNotIn applies the NotIn predicate on the "purpose" field.
+func PurposeNotIn(vs ...Purpose) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldPurpose, vs...))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIsNil applies the IsNil predicate on the "code_verifier" field.
+func CodeVerifierIsNil() predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIsNull(FieldCodeVerifier))
+}
+
+// CodeVerifierNotNil applies the NotNil predicate on the "code_verifier" field.
+func CodeVerifierNotNil() predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotNull(FieldCodeVerifier))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// ReturnToEQ applies the EQ predicate on the "return_to" field.
+func ReturnToEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldReturnTo, v))
+}
+
+// ReturnToNEQ applies the NEQ predicate on the "return_to" field.
+func ReturnToNEQ(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldReturnTo, v))
+}
+
+// ReturnToIn applies the In predicate on the "return_to" field.
+func ReturnToIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldReturnTo, vs...))
+}
+
+// ReturnToNotIn applies the NotIn predicate on the "return_to" field.
+func ReturnToNotIn(vs ...string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldReturnTo, vs...))
+}
+
+// ReturnToGT applies the GT predicate on the "return_to" field.
+func ReturnToGT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldReturnTo, v))
+}
+
+// ReturnToGTE applies the GTE predicate on the "return_to" field.
+func ReturnToGTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldReturnTo, v))
+}
+
+// ReturnToLT applies the LT predicate on the "return_to" field.
+func ReturnToLT(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldReturnTo, v))
+}
+
+// ReturnToLTE applies the LTE predicate on the "return_to" field.
+func ReturnToLTE(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldReturnTo, v))
+}
+
+// ReturnToContains applies the Contains predicate on the "return_to" field.
+func ReturnToContains(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContains(FieldReturnTo, v))
+}
+
+// ReturnToHasPrefix applies the HasPrefix predicate on the "return_to" field.
+func ReturnToHasPrefix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasPrefix(FieldReturnTo, v))
+}
+
+// ReturnToHasSuffix applies the HasSuffix predicate on the "return_to" field.
+func ReturnToHasSuffix(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldHasSuffix(FieldReturnTo, v))
+}
+
+// ReturnToIsNil applies the IsNil predicate on the "return_to" field.
+func ReturnToIsNil() predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIsNull(FieldReturnTo))
+}
+
+// ReturnToNotNil applies the NotNil predicate on the "return_to" field.
+func ReturnToNotNil() predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotNull(FieldReturnTo))
+}
+
+// ReturnToEqualFold applies the EqualFold predicate on the "return_to" field.
+func ReturnToEqualFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEqualFold(FieldReturnTo, v))
+}
+
+// ReturnToContainsFold applies the ContainsFold predicate on the "return_to" field.
+func ReturnToContainsFold(v string) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldContainsFold(FieldReturnTo, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.OAuthFlow {
+	return predicate.OAuthFlow(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.OAuthFlow {
+	return predicate.OAuthFlow(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthFlow) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthFlow) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthFlow) predicate.OAuthFlow {
+	return predicate.OAuthFlow(sql.NotPredicates(p))
+}
@@ -0,0 +1,443 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// OAuthFlowCreate is the builder for creating a OAuthFlow entity.
+type OAuthFlowCreate struct {
+	config
+	mutation *OAuthFlowMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OAuthFlowCreate) SetCreatedAt(v time.Time) *OAuthFlowCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableCreatedAt(v *time.Time) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OAuthFlowCreate) SetUpdatedAt(v time.Time) *OAuthFlowCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableUpdatedAt(v *time.Time) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_c *OAuthFlowCreate) SetStateHash(v string) *OAuthFlowCreate {
+	_c.mutation.SetStateHash(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *OAuthFlowCreate) SetProvider(v string) *OAuthFlowCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetPurpose sets the "purpose" field.
+func (_c *OAuthFlowCreate) SetPurpose(v oauthflow.Purpose) *OAuthFlowCreate {
+	_c.mutation.SetPurpose(v)
+	return _c
+}
+
+// SetNillablePurpose sets the "purpose" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillablePurpose(v *oauthflow.Purpose) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetPurpose(*v)
+	}
+	return _c
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_c *OAuthFlowCreate) SetCodeVerifier(v string) *OAuthFlowCreate {
+	_c.mutation.SetCodeVerifier(v)
+	return _c
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableCodeVerifier(v *string) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetCodeVerifier(*v)
+	}
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *OAuthFlowCreate) SetNonce(v string) *OAuthFlowCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetReturnTo sets the "return_to" field.
+func (_c *OAuthFlowCreate) SetReturnTo(v string) *OAuthFlowCreate {
+	_c.mutation.SetReturnTo(v)
+	return _c
+}
+
+// SetNillableReturnTo sets the "return_to" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableReturnTo(v *string) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetReturnTo(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OAuthFlowCreate) SetExpiresAt(v time.Time) *OAuthFlowCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableExpiresAt(v *time.Time) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OAuthFlowCreate) SetID(v uuid.UUID) *OAuthFlowCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableID(v *uuid.UUID) *OAuthFlowCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *OAuthFlowCreate) SetUserID(id uuid.UUID) *OAuthFlowCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_c *OAuthFlowCreate) SetNillableUserID(id *uuid.UUID) *OAuthFlowCreate {
+	if id != nil {
+		_c = _c.SetUserID(*id)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OAuthFlowCreate) SetUser(v *User) *OAuthFlowCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the OAuthFlowMutation object of the builder.
+func (_c *OAuthFlowCreate) Mutation() *OAuthFlowMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthFlow in the database.
+func (_c *OAuthFlowCreate) Save(ctx context.Context) (*OAuthFlow, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthFlowCreate) SaveX(ctx context.Context) *OAuthFlow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthFlowCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthFlowCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthFlowCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthflow.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := oauthflow.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		v := oauthflow.DefaultPurpose
+		_c.mutation.SetPurpose(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := oauthflow.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := oauthflow.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthFlowCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OAuthFlow.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OAuthFlow.updated_at"`)}
+	}
+	if _, ok := _c.mutation.StateHash(); !ok {
+		return &ValidationError{Name: "state_hash", err: errors.New(`ent: missing required field "OAuthFlow.state_hash"`)}
+	}
+	if v, ok := _c.mutation.StateHash(); ok {
+		if err := oauthflow.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.state_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "OAuthFlow.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := oauthflow.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "OAuthFlow.purpose"`)}
+	}
+	if v, ok := _c.mutation.Purpose(); ok {
+		if err := oauthflow.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.purpose": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.CodeVerifier(); ok {
+		if err := oauthflow.CodeVerifierValidator(v); err != nil {
+			return &ValidationError{Name: "code_verifier", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.code_verifier": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Nonce(); !ok {
+		return &ValidationError{Name: "nonce", err: errors.New(`ent: missing required field "OAuthFlow.nonce"`)}
+	}
+	if v, ok := _c.mutation.Nonce(); ok {
+		if err := oauthflow.NonceValidator(v); err != nil {
+			return &ValidationError{Name: "nonce", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.nonce": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ReturnTo(); ok {
+		if err := oauthflow.ReturnToValidator(v); err != nil {
+			return &ValidationError{Name: "return_to", err: fmt.Errorf(`ent: validator failed for field "OAuthFlow.return_to": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OAuthFlow.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *OAuthFlowCreate) sqlSave(ctx context.Context) (*OAuthFlow, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthFlowCreate) createSpec() (*OAuthFlow, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthFlow{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthflow.Table, sqlgraph.NewFieldSpec(oauthflow.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthflow.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(oauthflow.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.StateHash(); ok {
+		_spec.SetField(oauthflow.FieldStateHash, field.TypeString, value)
+		_node.StateHash = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(oauthflow.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Purpose(); ok {
+		_spec.SetField(oauthflow.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := _c.mutation.CodeVerifier(); ok {
+		_spec.SetField(oauthflow.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(oauthflow.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.ReturnTo(); ok {
+		_spec.SetField(oauthflow.FieldReturnTo, field.TypeString, value)
+		_node.ReturnTo = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthflow.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthflow.UserTable,
+			Columns: []string{oauthflow.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_oauth_flows = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OAuthFlowCreateBulk is the builder for creating many OAuthFlow entities in bulk.
+type OAuthFlowCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthFlowCreate
+}
+
+// Save creates the OAuthFlow entities in the database.
+func (_c *OAuthFlowCreateBulk) Save(ctx context.Context) ([]*OAuthFlow, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthFlow, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthFlowMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthFlowCreateBulk) SaveX(ctx context.Context) []*OAuthFlow {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthFlowCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthFlowCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
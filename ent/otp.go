@@ -23,12 +23,14 @@ type OTP struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
-	// Code holds the value of the "code" field.
-	Code string `json:"code,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
 	// Type holds the value of the "type" field.
 	Type otp.Type `json:"type,omitempty"`
 	// Used holds the value of the "used" field.
 	Used bool `json:"used,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -65,7 +67,9 @@ func (*OTP) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case otp.FieldUsed:
 			values[i] = new(sql.NullBool)
-		case otp.FieldCode, otp.FieldType:
+		case otp.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case otp.FieldCodeHash, otp.FieldType:
 			values[i] = new(sql.NullString)
 		case otp.FieldCreatedAt, otp.FieldUpdatedAt, otp.FieldExpiresAt:
 			values[i] = new(sql.NullTime)
@@ -106,11 +110,11 @@ func (_m *OTP) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
-		case otp.FieldCode:
+		case otp.FieldCodeHash:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field code", values[i])
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
 			} else if value.Valid {
-				_m.Code = value.String
+				_m.CodeHash = value.String
 			}
 		case otp.FieldType:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -124,6 +128,12 @@ func (_m *OTP) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Used = value.Bool
 			}
+		case otp.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
 		case otp.FieldExpiresAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
@@ -184,8 +194,7 @@ func (_m *OTP) String() string {
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("code=")
-	builder.WriteString(_m.Code)
+	builder.WriteString("code_hash=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Type))
@@ -193,6 +202,9 @@ func (_m *OTP) String() string {
 	builder.WriteString("used=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Used))
 	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -20,12 +20,14 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
-	// FieldCode holds the string denoting the code field in the database.
-	FieldCode = "code"
+	// FieldCodeHash holds the string denoting the code_hash field in the database.
+	FieldCodeHash = "code_hash"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
 	// FieldUsed holds the string denoting the used field in the database.
 	FieldUsed = "used"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -46,9 +48,10 @@ var Columns = []string{
 	FieldID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
-	FieldCode,
+	FieldCodeHash,
 	FieldType,
 	FieldUsed,
+	FieldAttempts,
 	FieldExpiresAt,
 }
 
@@ -80,14 +83,12 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
-	// DefaultCode holds the default value on creation for the "code" field.
-	DefaultCode func() string
-	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
-	CodeValidator func(string) error
+	// CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	CodeHashValidator func(string) error
 	// DefaultUsed holds the default value on creation for the "used" field.
 	DefaultUsed bool
-	// DefaultExpiresAt holds the default value on creation for the "expires_at" field.
-	DefaultExpiresAt func() time.Time
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -100,8 +101,13 @@ const DefaultType = TypeLogin
 
 // Type values.
 const (
-	TypeLogin         Type = "login"
-	TypePasswordReset Type = "password_reset"
+	TypeLogin             Type = "login"
+	TypePasswordReset     Type = "password_reset"
+	TypeReauthentication  Type = "reauthentication"
+	TypeEmailVerification Type = "email_verification"
+	TypePhoneVerification Type = "phone_verification"
+	TypeEmailChange       Type = "email_change"
+	TypeAccountLink       Type = "account_link"
 )
 
 func (_type Type) String() string {
@@ -111,7 +117,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeLogin, TypePasswordReset:
+	case TypeLogin, TypePasswordReset, TypeReauthentication, TypeEmailVerification, TypePhoneVerification, TypeEmailChange, TypeAccountLink:
 		return nil
 	default:
 		return fmt.Errorf("otp: invalid enum value for type field: %q", _type)
@@ -136,9 +142,9 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
-// ByCode orders the results by the code field.
-func ByCode(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldCode, opts...).ToFunc()
+// ByCodeHash orders the results by the code_hash field.
+func ByCodeHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCodeHash, opts...).ToFunc()
 }
 
 // ByType orders the results by the type field.
@@ -151,6 +157,11 @@ func ByUsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUsed, opts...).ToFunc()
 }
 
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
 // ByExpiresAt orders the results by the expires_at field.
 func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
@@ -66,9 +66,9 @@ func UpdatedAt(v time.Time) predicate.OTP {
 	return predicate.OTP(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
-// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
-func Code(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldEQ(FieldCode, v))
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldEQ(FieldCodeHash, v))
 }
 
 // Used applies equality check predicate on the "used" field. It's identical to UsedEQ.
@@ -76,6 +76,11 @@ func Used(v bool) predicate.OTP {
 	return predicate.OTP(sql.FieldEQ(FieldUsed, v))
 }
 
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldEQ(FieldAttempts, v))
+}
+
 // ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
 func ExpiresAt(v time.Time) predicate.OTP {
 	return predicate.OTP(sql.FieldEQ(FieldExpiresAt, v))
@@ -161,69 +166,79 @@ func UpdatedAtLTE(v time.Time) predicate.OTP {
 	return predicate.OTP(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
-// CodeEQ applies the EQ predicate on the "code" field.
-func CodeEQ(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldEQ(FieldCode, v))
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.OTP {
+	return predicate.OTP(sql.FieldIn(FieldCodeHash, vs...))
 }
 
-// CodeNEQ applies the NEQ predicate on the "code" field.
-func CodeNEQ(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldNEQ(FieldCode, v))
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.OTP {
+	return predicate.OTP(sql.FieldNotIn(FieldCodeHash, vs...))
 }
 
-// CodeIn applies the In predicate on the "code" field.
-func CodeIn(vs ...string) predicate.OTP {
-	return predicate.OTP(sql.FieldIn(FieldCode, vs...))
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldGT(FieldCodeHash, v))
 }
 
-// CodeNotIn applies the NotIn predicate on the "code" field.
-func CodeNotIn(vs ...string) predicate.OTP {
-	return predicate.OTP(sql.FieldNotIn(FieldCode, vs...))
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldGTE(FieldCodeHash, v))
 }
 
-// CodeGT applies the GT predicate on the "code" field.
-func CodeGT(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldGT(FieldCode, v))
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldLT(FieldCodeHash, v))
 }
 
-// CodeGTE applies the GTE predicate on the "code" field.
-func CodeGTE(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldGTE(FieldCode, v))
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldLTE(FieldCodeHash, v))
 }
 
-// CodeLT applies the LT predicate on the "code" field.
-func CodeLT(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldLT(FieldCode, v))
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldContains(FieldCodeHash, v))
 }
 
-// CodeLTE applies the LTE predicate on the "code" field.
-func CodeLTE(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldLTE(FieldCode, v))
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldHasPrefix(FieldCodeHash, v))
 }
 
-// CodeContains applies the Contains predicate on the "code" field.
-func CodeContains(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldContains(FieldCode, v))
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldHasSuffix(FieldCodeHash, v))
 }
 
-// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
-func CodeHasPrefix(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldHasPrefix(FieldCode, v))
+// CodeHashIsNil applies the IsNil predicate on the "code_hash" field.
+func CodeHashIsNil() predicate.OTP {
+	return predicate.OTP(sql.FieldIsNull(FieldCodeHash))
 }
 
-// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
-func CodeHasSuffix(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldHasSuffix(FieldCode, v))
+// CodeHashNotNil applies the NotNil predicate on the "code_hash" field.
+func CodeHashNotNil() predicate.OTP {
+	return predicate.OTP(sql.FieldNotNull(FieldCodeHash))
 }
 
-// CodeEqualFold applies the EqualFold predicate on the "code" field.
-func CodeEqualFold(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldEqualFold(FieldCode, v))
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldEqualFold(FieldCodeHash, v))
 }
 
-// CodeContainsFold applies the ContainsFold predicate on the "code" field.
-func CodeContainsFold(v string) predicate.OTP {
-	return predicate.OTP(sql.FieldContainsFold(FieldCode, v))
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.OTP {
+	return predicate.OTP(sql.FieldContainsFold(FieldCodeHash, v))
 }
 
 // TypeEQ applies the EQ predicate on the "type" field.
@@ -256,6 +271,46 @@ func UsedNEQ(v bool) predicate.OTP {
 	return predicate.OTP(sql.FieldNEQ(FieldUsed, v))
 }
 
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...int) predicate.OTP {
+	return predicate.OTP(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...int) predicate.OTP {
+	return predicate.OTP(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v int) predicate.OTP {
+	return predicate.OTP(sql.FieldLTE(FieldAttempts, v))
+}
+
 // ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
 func ExpiresAtEQ(v time.Time) predicate.OTP {
 	return predicate.OTP(sql.FieldEQ(FieldExpiresAt, v))
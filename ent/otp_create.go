@@ -50,16 +50,16 @@ func (_c *OTPCreate) SetNillableUpdatedAt(v *time.Time) *OTPCreate {
 	return _c
 }
 
-// SetCode sets the "code" field.
-func (_c *OTPCreate) SetCode(v string) *OTPCreate {
-	_c.mutation.SetCode(v)
+// SetCodeHash sets the "code_hash" field.
+func (_c *OTPCreate) SetCodeHash(v string) *OTPCreate {
+	_c.mutation.SetCodeHash(v)
 	return _c
 }
 
-// SetNillableCode sets the "code" field if the given value is not nil.
-func (_c *OTPCreate) SetNillableCode(v *string) *OTPCreate {
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_c *OTPCreate) SetNillableCodeHash(v *string) *OTPCreate {
 	if v != nil {
-		_c.SetCode(*v)
+		_c.SetCodeHash(*v)
 	}
 	return _c
 }
@@ -92,20 +92,26 @@ func (_c *OTPCreate) SetNillableUsed(v *bool) *OTPCreate {
 	return _c
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (_c *OTPCreate) SetExpiresAt(v time.Time) *OTPCreate {
-	_c.mutation.SetExpiresAt(v)
+// SetAttempts sets the "attempts" field.
+func (_c *OTPCreate) SetAttempts(v int) *OTPCreate {
+	_c.mutation.SetAttempts(v)
 	return _c
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (_c *OTPCreate) SetNillableExpiresAt(v *time.Time) *OTPCreate {
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *OTPCreate) SetNillableAttempts(v *int) *OTPCreate {
 	if v != nil {
-		_c.SetExpiresAt(*v)
+		_c.SetAttempts(*v)
 	}
 	return _c
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OTPCreate) SetExpiresAt(v time.Time) *OTPCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *OTPCreate) SetID(v uuid.UUID) *OTPCreate {
 	_c.mutation.SetID(v)
@@ -174,10 +180,6 @@ func (_c *OTPCreate) defaults() {
 		v := otp.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
-	if _, ok := _c.mutation.Code(); !ok {
-		v := otp.DefaultCode()
-		_c.mutation.SetCode(v)
-	}
 	if _, ok := _c.mutation.GetType(); !ok {
 		v := otp.DefaultType
 		_c.mutation.SetType(v)
@@ -186,9 +188,9 @@ func (_c *OTPCreate) defaults() {
 		v := otp.DefaultUsed
 		_c.mutation.SetUsed(v)
 	}
-	if _, ok := _c.mutation.ExpiresAt(); !ok {
-		v := otp.DefaultExpiresAt()
-		_c.mutation.SetExpiresAt(v)
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := otp.DefaultAttempts
+		_c.mutation.SetAttempts(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := otp.DefaultID()
@@ -204,12 +206,9 @@ func (_c *OTPCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OTP.updated_at"`)}
 	}
-	if _, ok := _c.mutation.Code(); !ok {
-		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "OTP.code"`)}
-	}
-	if v, ok := _c.mutation.Code(); ok {
-		if err := otp.CodeValidator(v); err != nil {
-			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "OTP.code": %w`, err)}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := otp.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OTP.code_hash": %w`, err)}
 		}
 	}
 	if _, ok := _c.mutation.GetType(); !ok {
@@ -223,6 +222,9 @@ func (_c *OTPCreate) check() error {
 	if _, ok := _c.mutation.Used(); !ok {
 		return &ValidationError{Name: "used", err: errors.New(`ent: missing required field "OTP.used"`)}
 	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "OTP.attempts"`)}
+	}
 	if _, ok := _c.mutation.ExpiresAt(); !ok {
 		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OTP.expires_at"`)}
 	}
@@ -272,9 +274,9 @@ func (_c *OTPCreate) createSpec() (*OTP, *sqlgraph.CreateSpec) {
 		_spec.SetField(otp.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
-	if value, ok := _c.mutation.Code(); ok {
-		_spec.SetField(otp.FieldCode, field.TypeString, value)
-		_node.Code = value
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(otp.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
 	}
 	if value, ok := _c.mutation.GetType(); ok {
 		_spec.SetField(otp.FieldType, field.TypeEnum, value)
@@ -284,6 +286,10 @@ func (_c *OTPCreate) createSpec() (*OTP, *sqlgraph.CreateSpec) {
 		_spec.SetField(otp.FieldUsed, field.TypeBool, value)
 		_node.Used = value
 	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(otp.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
 	if value, ok := _c.mutation.ExpiresAt(); ok {
 		_spec.SetField(otp.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
@@ -64,6 +64,27 @@ func (_u *OTPUpdate) SetNillableUsed(v *bool) *OTPUpdate {
 	return _u
 }
 
+// SetAttempts sets the "attempts" field.
+func (_u *OTPUpdate) SetAttempts(v int) *OTPUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OTPUpdate) SetNillableAttempts(v *int) *OTPUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OTPUpdate) AddAttempts(v int) *OTPUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *OTPUpdate) SetUserID(id uuid.UUID) *OTPUpdate {
 	_u.mutation.SetUserID(id)
@@ -150,12 +171,21 @@ func (_u *OTPUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(otp.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(otp.FieldCodeHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(otp.FieldType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Used(); ok {
 		_spec.SetField(otp.FieldUsed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(otp.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(otp.FieldAttempts, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -239,6 +269,27 @@ func (_u *OTPUpdateOne) SetNillableUsed(v *bool) *OTPUpdateOne {
 	return _u
 }
 
+// SetAttempts sets the "attempts" field.
+func (_u *OTPUpdateOne) SetAttempts(v int) *OTPUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *OTPUpdateOne) SetNillableAttempts(v *int) *OTPUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *OTPUpdateOne) AddAttempts(v int) *OTPUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *OTPUpdateOne) SetUserID(id uuid.UUID) *OTPUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -355,12 +406,21 @@ func (_u *OTPUpdateOne) sqlSave(ctx context.Context) (_node *OTP, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(otp.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(otp.FieldCodeHash, field.TypeString)
+	}
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(otp.FieldType, field.TypeEnum, value)
 	}
 	if value, ok := _u.mutation.Used(); ok {
 		_spec.SetField(otp.FieldUsed, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(otp.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(otp.FieldAttempts, field.TypeInt, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -27,6 +28,12 @@ type Profile struct {
 	Name string `json:"name,omitempty"`
 	// Birthday holds the value of the "birthday" field.
 	Birthday time.Time `json:"birthday,omitempty"`
+	// AvatarURL holds the value of the "avatar_url" field.
+	AvatarURL *string `json:"avatar_url,omitempty"`
+	// AvatarSizes holds the value of the "avatar_sizes" field.
+	AvatarSizes map[string]string `json:"avatar_sizes,omitempty"`
+	// AvatarKey holds the value of the "avatar_key" field.
+	AvatarKey string `json:"-"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProfileQuery when eager-loading is set.
 	Edges        ProfileEdges `json:"edges"`
@@ -59,7 +66,9 @@ func (*Profile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case profile.FieldName:
+		case profile.FieldAvatarSizes:
+			values[i] = new([]byte)
+		case profile.FieldName, profile.FieldAvatarURL, profile.FieldAvatarKey:
 			values[i] = new(sql.NullString)
 		case profile.FieldCreatedAt, profile.FieldUpdatedAt, profile.FieldBirthday:
 			values[i] = new(sql.NullTime)
@@ -112,6 +121,27 @@ func (_m *Profile) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Birthday = value.Time
 			}
+		case profile.FieldAvatarURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url", values[i])
+			} else if value.Valid {
+				_m.AvatarURL = new(string)
+				*_m.AvatarURL = value.String
+			}
+		case profile.FieldAvatarSizes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_sizes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AvatarSizes); err != nil {
+					return fmt.Errorf("unmarshal field avatar_sizes: %w", err)
+				}
+			}
+		case profile.FieldAvatarKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_key", values[i])
+			} else if value.Valid {
+				_m.AvatarKey = value.String
+			}
 		case profile.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_profile", values[i])
@@ -171,6 +201,16 @@ func (_m *Profile) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("birthday=")
 	builder.WriteString(_m.Birthday.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.AvatarURL; v != nil {
+		builder.WriteString("avatar_url=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("avatar_sizes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AvatarSizes))
+	builder.WriteString(", ")
+	builder.WriteString("avatar_key=<sensitive>")
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -70,6 +70,40 @@ func (_c *ProfileCreate) SetNillableBirthday(v *time.Time) *ProfileCreate {
 	return _c
 }
 
+// SetAvatarURL sets the "avatar_url" field.
+func (_c *ProfileCreate) SetAvatarURL(v string) *ProfileCreate {
+	_c.mutation.SetAvatarURL(v)
+	return _c
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableAvatarURL(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetAvatarURL(*v)
+	}
+	return _c
+}
+
+// SetAvatarSizes sets the "avatar_sizes" field.
+func (_c *ProfileCreate) SetAvatarSizes(v map[string]string) *ProfileCreate {
+	_c.mutation.SetAvatarSizes(v)
+	return _c
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (_c *ProfileCreate) SetAvatarKey(v string) *ProfileCreate {
+	_c.mutation.SetAvatarKey(v)
+	return _c
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (_c *ProfileCreate) SetNillableAvatarKey(v *string) *ProfileCreate {
+	if v != nil {
+		_c.SetAvatarKey(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ProfileCreate) SetID(v uuid.UUID) *ProfileCreate {
 	_c.mutation.SetID(v)
@@ -160,6 +194,16 @@ func (_c *ProfileCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.AvatarKey(); ok {
+		if err := profile.AvatarKeyValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_key", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_key": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Profile.user"`)}
 	}
@@ -214,6 +258,18 @@ func (_c *ProfileCreate) createSpec() (*Profile, *sqlgraph.CreateSpec) {
 		_spec.SetField(profile.FieldBirthday, field.TypeTime, value)
 		_node.Birthday = value
 	}
+	if value, ok := _c.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = &value
+	}
+	if value, ok := _c.mutation.AvatarSizes(); ok {
+		_spec.SetField(profile.FieldAvatarSizes, field.TypeJSON, value)
+		_node.AvatarSizes = value
+	}
+	if value, ok := _c.mutation.AvatarKey(); ok {
+		_spec.SetField(profile.FieldAvatarKey, field.TypeString, value)
+		_node.AvatarKey = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -70,6 +70,58 @@ func (_u *ProfileUpdate) ClearBirthday() *ProfileUpdate {
 	return _u
 }
 
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ProfileUpdate) SetAvatarURL(v string) *ProfileUpdate {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableAvatarURL(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ProfileUpdate) ClearAvatarURL() *ProfileUpdate {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetAvatarSizes sets the "avatar_sizes" field.
+func (_u *ProfileUpdate) SetAvatarSizes(v map[string]string) *ProfileUpdate {
+	_u.mutation.SetAvatarSizes(v)
+	return _u
+}
+
+// ClearAvatarSizes clears the value of the "avatar_sizes" field.
+func (_u *ProfileUpdate) ClearAvatarSizes() *ProfileUpdate {
+	_u.mutation.ClearAvatarSizes()
+	return _u
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (_u *ProfileUpdate) SetAvatarKey(v string) *ProfileUpdate {
+	_u.mutation.SetAvatarKey(v)
+	return _u
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (_u *ProfileUpdate) SetNillableAvatarKey(v *string) *ProfileUpdate {
+	if v != nil {
+		_u.SetAvatarKey(*v)
+	}
+	return _u
+}
+
+// ClearAvatarKey clears the value of the "avatar_key" field.
+func (_u *ProfileUpdate) ClearAvatarKey() *ProfileUpdate {
+	_u.mutation.ClearAvatarKey()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *ProfileUpdate) SetUserID(id uuid.UUID) *ProfileUpdate {
 	_u.mutation.SetUserID(id)
@@ -135,6 +187,16 @@ func (_u *ProfileUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarKey(); ok {
+		if err := profile.AvatarKeyValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_key", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_key": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
 	}
@@ -165,6 +227,24 @@ func (_u *ProfileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.BirthdayCleared() {
 		_spec.ClearField(profile.FieldBirthday, field.TypeTime)
 	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(profile.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvatarSizes(); ok {
+		_spec.SetField(profile.FieldAvatarSizes, field.TypeJSON, value)
+	}
+	if _u.mutation.AvatarSizesCleared() {
+		_spec.ClearField(profile.FieldAvatarSizes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvatarKey(); ok {
+		_spec.SetField(profile.FieldAvatarKey, field.TypeString, value)
+	}
+	if _u.mutation.AvatarKeyCleared() {
+		_spec.ClearField(profile.FieldAvatarKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -254,6 +334,58 @@ func (_u *ProfileUpdateOne) ClearBirthday() *ProfileUpdateOne {
 	return _u
 }
 
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ProfileUpdateOne) SetAvatarURL(v string) *ProfileUpdateOne {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableAvatarURL(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ProfileUpdateOne) ClearAvatarURL() *ProfileUpdateOne {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetAvatarSizes sets the "avatar_sizes" field.
+func (_u *ProfileUpdateOne) SetAvatarSizes(v map[string]string) *ProfileUpdateOne {
+	_u.mutation.SetAvatarSizes(v)
+	return _u
+}
+
+// ClearAvatarSizes clears the value of the "avatar_sizes" field.
+func (_u *ProfileUpdateOne) ClearAvatarSizes() *ProfileUpdateOne {
+	_u.mutation.ClearAvatarSizes()
+	return _u
+}
+
+// SetAvatarKey sets the "avatar_key" field.
+func (_u *ProfileUpdateOne) SetAvatarKey(v string) *ProfileUpdateOne {
+	_u.mutation.SetAvatarKey(v)
+	return _u
+}
+
+// SetNillableAvatarKey sets the "avatar_key" field if the given value is not nil.
+func (_u *ProfileUpdateOne) SetNillableAvatarKey(v *string) *ProfileUpdateOne {
+	if v != nil {
+		_u.SetAvatarKey(*v)
+	}
+	return _u
+}
+
+// ClearAvatarKey clears the value of the "avatar_key" field.
+func (_u *ProfileUpdateOne) ClearAvatarKey() *ProfileUpdateOne {
+	_u.mutation.ClearAvatarKey()
+	return _u
+}
+
 // SetUserID sets the "user" edge to the User entity by ID.
 func (_u *ProfileUpdateOne) SetUserID(id uuid.UUID) *ProfileUpdateOne {
 	_u.mutation.SetUserID(id)
@@ -332,6 +464,16 @@ func (_u *ProfileUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Profile.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AvatarURL(); ok {
+		if err := profile.AvatarURLValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_url", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AvatarKey(); ok {
+		if err := profile.AvatarKeyValidator(v); err != nil {
+			return &ValidationError{Name: "avatar_key", err: fmt.Errorf(`ent: validator failed for field "Profile.avatar_key": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Profile.user"`)
 	}
@@ -379,6 +521,24 @@ func (_u *ProfileUpdateOne) sqlSave(ctx context.Context) (_node *Profile, err er
 	if _u.mutation.BirthdayCleared() {
 		_spec.ClearField(profile.FieldBirthday, field.TypeTime)
 	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(profile.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(profile.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvatarSizes(); ok {
+		_spec.SetField(profile.FieldAvatarSizes, field.TypeJSON, value)
+	}
+	if _u.mutation.AvatarSizesCleared() {
+		_spec.ClearField(profile.FieldAvatarSizes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AvatarKey(); ok {
+		_spec.SetField(profile.FieldAvatarKey, field.TypeString, value)
+	}
+	if _u.mutation.AvatarKeyCleared() {
+		_spec.ClearField(profile.FieldAvatarKey, field.TypeString)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -0,0 +1,442 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/NikSchaefer/go-fiber/ent/ratelimitbucket"
+)
+
+// RateLimitBucketUpdate is the builder for updating RateLimitBucket entities.
+type RateLimitBucketUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RateLimitBucketMutation
+}
+
+// Where appends a list predicates to the RateLimitBucketUpdate builder.
+func (_u *RateLimitBucketUpdate) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RateLimitBucketUpdate) SetUpdatedAt(v time.Time) *RateLimitBucketUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *RateLimitBucketUpdate) SetValue(v float64) *RateLimitBucketUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *RateLimitBucketUpdate) SetNillableValue(v *float64) *RateLimitBucketUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *RateLimitBucketUpdate) AddValue(v float64) *RateLimitBucketUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetPrevious sets the "previous" field.
+func (_u *RateLimitBucketUpdate) SetPrevious(v float64) *RateLimitBucketUpdate {
+	_u.mutation.ResetPrevious()
+	_u.mutation.SetPrevious(v)
+	return _u
+}
+
+// SetNillablePrevious sets the "previous" field if the given value is not nil.
+func (_u *RateLimitBucketUpdate) SetNillablePrevious(v *float64) *RateLimitBucketUpdate {
+	if v != nil {
+		_u.SetPrevious(*v)
+	}
+	return _u
+}
+
+// AddPrevious adds value to the "previous" field.
+func (_u *RateLimitBucketUpdate) AddPrevious(v float64) *RateLimitBucketUpdate {
+	_u.mutation.AddPrevious(v)
+	return _u
+}
+
+// SetStamp sets the "stamp" field.
+func (_u *RateLimitBucketUpdate) SetStamp(v time.Time) *RateLimitBucketUpdate {
+	_u.mutation.SetStamp(v)
+	return _u
+}
+
+// SetNillableStamp sets the "stamp" field if the given value is not nil.
+func (_u *RateLimitBucketUpdate) SetNillableStamp(v *time.Time) *RateLimitBucketUpdate {
+	if v != nil {
+		_u.SetStamp(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *RateLimitBucketUpdate) SetVersion(v int) *RateLimitBucketUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RateLimitBucketUpdate) SetNillableVersion(v *int) *RateLimitBucketUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *RateLimitBucketUpdate) AddVersion(v int) *RateLimitBucketUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RateLimitBucketUpdate) SetExpiresAt(v time.Time) *RateLimitBucketUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RateLimitBucketUpdate) SetNillableExpiresAt(v *time.Time) *RateLimitBucketUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (_u *RateLimitBucketUpdate) Mutation() *RateLimitBucketMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RateLimitBucketUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RateLimitBucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RateLimitBucketUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RateLimitBucketUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RateLimitBucketUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ratelimitbucket.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RateLimitBucketUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitbucket.Table, ratelimitbucket.Columns, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(ratelimitbucket.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(ratelimitbucket.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Previous(); ok {
+		_spec.SetField(ratelimitbucket.FieldPrevious, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrevious(); ok {
+		_spec.AddField(ratelimitbucket.FieldPrevious, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Stamp(); ok {
+		_spec.SetField(ratelimitbucket.FieldStamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(ratelimitbucket.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(ratelimitbucket.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldExpiresAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitbucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RateLimitBucketUpdateOne is the builder for updating a single RateLimitBucket entity.
+type RateLimitBucketUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RateLimitBucketMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RateLimitBucketUpdateOne) SetUpdatedAt(v time.Time) *RateLimitBucketUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *RateLimitBucketUpdateOne) SetValue(v float64) *RateLimitBucketUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *RateLimitBucketUpdateOne) SetNillableValue(v *float64) *RateLimitBucketUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *RateLimitBucketUpdateOne) AddValue(v float64) *RateLimitBucketUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetPrevious sets the "previous" field.
+func (_u *RateLimitBucketUpdateOne) SetPrevious(v float64) *RateLimitBucketUpdateOne {
+	_u.mutation.ResetPrevious()
+	_u.mutation.SetPrevious(v)
+	return _u
+}
+
+// SetNillablePrevious sets the "previous" field if the given value is not nil.
+func (_u *RateLimitBucketUpdateOne) SetNillablePrevious(v *float64) *RateLimitBucketUpdateOne {
+	if v != nil {
+		_u.SetPrevious(*v)
+	}
+	return _u
+}
+
+// AddPrevious adds value to the "previous" field.
+func (_u *RateLimitBucketUpdateOne) AddPrevious(v float64) *RateLimitBucketUpdateOne {
+	_u.mutation.AddPrevious(v)
+	return _u
+}
+
+// SetStamp sets the "stamp" field.
+func (_u *RateLimitBucketUpdateOne) SetStamp(v time.Time) *RateLimitBucketUpdateOne {
+	_u.mutation.SetStamp(v)
+	return _u
+}
+
+// SetNillableStamp sets the "stamp" field if the given value is not nil.
+func (_u *RateLimitBucketUpdateOne) SetNillableStamp(v *time.Time) *RateLimitBucketUpdateOne {
+	if v != nil {
+		_u.SetStamp(*v)
+	}
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *RateLimitBucketUpdateOne) SetVersion(v int) *RateLimitBucketUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *RateLimitBucketUpdateOne) SetNillableVersion(v *int) *RateLimitBucketUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *RateLimitBucketUpdateOne) AddVersion(v int) *RateLimitBucketUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *RateLimitBucketUpdateOne) SetExpiresAt(v time.Time) *RateLimitBucketUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *RateLimitBucketUpdateOne) SetNillableExpiresAt(v *time.Time) *RateLimitBucketUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the RateLimitBucketMutation object of the builder.
+func (_u *RateLimitBucketUpdateOne) Mutation() *RateLimitBucketMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RateLimitBucketUpdate builder.
+func (_u *RateLimitBucketUpdateOne) Where(ps ...predicate.RateLimitBucket) *RateLimitBucketUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RateLimitBucketUpdateOne) Select(field string, fields ...string) *RateLimitBucketUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RateLimitBucket entity.
+func (_u *RateLimitBucketUpdateOne) Save(ctx context.Context) (*RateLimitBucket, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RateLimitBucketUpdateOne) SaveX(ctx context.Context) *RateLimitBucket {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RateLimitBucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RateLimitBucketUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RateLimitBucketUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ratelimitbucket.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *RateLimitBucketUpdateOne) sqlSave(ctx context.Context) (_node *RateLimitBucket, err error) {
+	_spec := sqlgraph.NewUpdateSpec(ratelimitbucket.Table, ratelimitbucket.Columns, sqlgraph.NewFieldSpec(ratelimitbucket.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RateLimitBucket.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ratelimitbucket.FieldID)
+		for _, f := range fields {
+			if !ratelimitbucket.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ratelimitbucket.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(ratelimitbucket.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(ratelimitbucket.FieldValue, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Previous(); ok {
+		_spec.SetField(ratelimitbucket.FieldPrevious, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrevious(); ok {
+		_spec.AddField(ratelimitbucket.FieldPrevious, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Stamp(); ok {
+		_spec.SetField(ratelimitbucket.FieldStamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(ratelimitbucket.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(ratelimitbucket.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ratelimitbucket.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &RateLimitBucket{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ratelimitbucket.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,11 +6,26 @@ import (
 	"time"
 
 	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/apikey"
+	"github.com/NikSchaefer/go-fiber/ent/auditevent"
+	"github.com/NikSchaefer/go-fiber/ent/contactchange"
+	"github.com/NikSchaefer/go-fiber/ent/loginthrottle"
+	"github.com/NikSchaefer/go-fiber/ent/magiclink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
 	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/passkey"
 	"github.com/NikSchaefer/go-fiber/ent/profile"
+	"github.com/NikSchaefer/go-fiber/ent/ratelimitbucket"
+	"github.com/NikSchaefer/go-fiber/ent/recoverycode"
+	"github.com/NikSchaefer/go-fiber/ent/refreshtoken"
 	"github.com/NikSchaefer/go-fiber/ent/schema"
 	"github.com/NikSchaefer/go-fiber/ent/session"
+	"github.com/NikSchaefer/go-fiber/ent/tokenfamily"
+	"github.com/NikSchaefer/go-fiber/ent/totpcredential"
 	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
 	"github.com/google/uuid"
 )
 
@@ -18,6 +33,79 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyMixin := schema.APIKey{}.Mixin()
+	apikeyMixinFields0 := apikeyMixin[0].Fields()
+	_ = apikeyMixinFields0
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyMixinFields0[1].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyMixinFields0[2].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[0].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[1].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = func() func(string) error {
+		validators := apikeyDescPrefix.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(prefix string) error {
+			for _, fn := range fns {
+				if err := fn(prefix); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[2].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = func() func(string) error {
+		validators := apikeyDescSecretHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret_hash string) error {
+			for _, fn := range fns {
+				if err := fn(secret_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyMixinFields0[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
 	accountMixin := schema.Account{}.Mixin()
 	accountMixinFields0 := accountMixin[0].Fields()
 	_ = accountMixinFields0
@@ -45,6 +133,387 @@ func init() {
 	accountDescID := accountMixinFields0[0].Descriptor()
 	// account.DefaultID holds the default value on creation for the id field.
 	account.DefaultID = accountDescID.Default.(func() uuid.UUID)
+	accountlinkMixin := schema.AccountLink{}.Mixin()
+	accountlinkMixinFields0 := accountlinkMixin[0].Fields()
+	_ = accountlinkMixinFields0
+	accountlinkFields := schema.AccountLink{}.Fields()
+	_ = accountlinkFields
+	// accountlinkDescCreatedAt is the schema descriptor for created_at field.
+	accountlinkDescCreatedAt := accountlinkMixinFields0[1].Descriptor()
+	// accountlink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accountlink.DefaultCreatedAt = accountlinkDescCreatedAt.Default.(func() time.Time)
+	// accountlinkDescUpdatedAt is the schema descriptor for updated_at field.
+	accountlinkDescUpdatedAt := accountlinkMixinFields0[2].Descriptor()
+	// accountlink.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	accountlink.DefaultUpdatedAt = accountlinkDescUpdatedAt.Default.(func() time.Time)
+	// accountlink.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	accountlink.UpdateDefaultUpdatedAt = accountlinkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountlinkDescTokenHash is the schema descriptor for token_hash field.
+	accountlinkDescTokenHash := accountlinkFields[0].Descriptor()
+	// accountlink.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	accountlink.TokenHashValidator = func() func(string) error {
+		validators := accountlinkDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountlinkDescProviderID is the schema descriptor for provider_id field.
+	accountlinkDescProviderID := accountlinkFields[2].Descriptor()
+	// accountlink.ProviderIDValidator is a validator for the "provider_id" field. It is called by the builders before save.
+	accountlink.ProviderIDValidator = func() func(string) error {
+		validators := accountlinkDescProviderID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider_id string) error {
+			for _, fn := range fns {
+				if err := fn(provider_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// accountlinkDescAttempts is the schema descriptor for attempts field.
+	accountlinkDescAttempts := accountlinkFields[3].Descriptor()
+	// accountlink.DefaultAttempts holds the default value on creation for the attempts field.
+	accountlink.DefaultAttempts = accountlinkDescAttempts.Default.(int)
+	// accountlinkDescExpiresAt is the schema descriptor for expires_at field.
+	accountlinkDescExpiresAt := accountlinkFields[4].Descriptor()
+	// accountlink.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	accountlink.DefaultExpiresAt = accountlinkDescExpiresAt.Default.(func() time.Time)
+	// accountlinkDescID is the schema descriptor for id field.
+	accountlinkDescID := accountlinkMixinFields0[0].Descriptor()
+	// accountlink.DefaultID holds the default value on creation for the id field.
+	accountlink.DefaultID = accountlinkDescID.Default.(func() uuid.UUID)
+	auditeventMixin := schema.AuditEvent{}.Mixin()
+	auditeventMixinFields0 := auditeventMixin[0].Fields()
+	_ = auditeventMixinFields0
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventMixinFields0[1].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	// auditeventDescUpdatedAt is the schema descriptor for updated_at field.
+	auditeventDescUpdatedAt := auditeventMixinFields0[2].Descriptor()
+	// auditevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditevent.DefaultUpdatedAt = auditeventDescUpdatedAt.Default.(func() time.Time)
+	// auditevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditevent.UpdateDefaultUpdatedAt = auditeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditeventDescIPAddress is the schema descriptor for ip_address field.
+	auditeventDescIPAddress := auditeventFields[2].Descriptor()
+	// auditevent.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	auditevent.IPAddressValidator = auditeventDescIPAddress.Validators[0].(func(string) error)
+	// auditeventDescUserAgent is the schema descriptor for user_agent field.
+	auditeventDescUserAgent := auditeventFields[3].Descriptor()
+	// auditevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	auditevent.UserAgentValidator = auditeventDescUserAgent.Validators[0].(func(string) error)
+	// auditeventDescID is the schema descriptor for id field.
+	auditeventDescID := auditeventMixinFields0[0].Descriptor()
+	// auditevent.DefaultID holds the default value on creation for the id field.
+	auditevent.DefaultID = auditeventDescID.Default.(func() uuid.UUID)
+	contactchangeMixin := schema.ContactChange{}.Mixin()
+	contactchangeMixinFields0 := contactchangeMixin[0].Fields()
+	_ = contactchangeMixinFields0
+	contactchangeFields := schema.ContactChange{}.Fields()
+	_ = contactchangeFields
+	// contactchangeDescCreatedAt is the schema descriptor for created_at field.
+	contactchangeDescCreatedAt := contactchangeMixinFields0[1].Descriptor()
+	// contactchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contactchange.DefaultCreatedAt = contactchangeDescCreatedAt.Default.(func() time.Time)
+	// contactchangeDescUpdatedAt is the schema descriptor for updated_at field.
+	contactchangeDescUpdatedAt := contactchangeMixinFields0[2].Descriptor()
+	// contactchange.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contactchange.DefaultUpdatedAt = contactchangeDescUpdatedAt.Default.(func() time.Time)
+	// contactchange.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contactchange.UpdateDefaultUpdatedAt = contactchangeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// contactchangeDescValue is the schema descriptor for value field.
+	contactchangeDescValue := contactchangeFields[1].Descriptor()
+	// contactchange.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	contactchange.ValueValidator = func() func(string) error {
+		validators := contactchangeDescValue.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(value string) error {
+			for _, fn := range fns {
+				if err := fn(value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// contactchangeDescPreviousValue is the schema descriptor for previous_value field.
+	contactchangeDescPreviousValue := contactchangeFields[2].Descriptor()
+	// contactchange.PreviousValueValidator is a validator for the "previous_value" field. It is called by the builders before save.
+	contactchange.PreviousValueValidator = contactchangeDescPreviousValue.Validators[0].(func(string) error)
+	// contactchangeDescRevertTokenHash is the schema descriptor for revert_token_hash field.
+	contactchangeDescRevertTokenHash := contactchangeFields[4].Descriptor()
+	// contactchange.RevertTokenHashValidator is a validator for the "revert_token_hash" field. It is called by the builders before save.
+	contactchange.RevertTokenHashValidator = contactchangeDescRevertTokenHash.Validators[0].(func(string) error)
+	// contactchangeDescID is the schema descriptor for id field.
+	contactchangeDescID := contactchangeMixinFields0[0].Descriptor()
+	// contactchange.DefaultID holds the default value on creation for the id field.
+	contactchange.DefaultID = contactchangeDescID.Default.(func() uuid.UUID)
+	loginthrottleMixin := schema.LoginThrottle{}.Mixin()
+	loginthrottleMixinFields0 := loginthrottleMixin[0].Fields()
+	_ = loginthrottleMixinFields0
+	loginthrottleFields := schema.LoginThrottle{}.Fields()
+	_ = loginthrottleFields
+	// loginthrottleDescCreatedAt is the schema descriptor for created_at field.
+	loginthrottleDescCreatedAt := loginthrottleMixinFields0[1].Descriptor()
+	// loginthrottle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginthrottle.DefaultCreatedAt = loginthrottleDescCreatedAt.Default.(func() time.Time)
+	// loginthrottleDescUpdatedAt is the schema descriptor for updated_at field.
+	loginthrottleDescUpdatedAt := loginthrottleMixinFields0[2].Descriptor()
+	// loginthrottle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loginthrottle.DefaultUpdatedAt = loginthrottleDescUpdatedAt.Default.(func() time.Time)
+	// loginthrottle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loginthrottle.UpdateDefaultUpdatedAt = loginthrottleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginthrottleDescKey is the schema descriptor for key field.
+	loginthrottleDescKey := loginthrottleFields[0].Descriptor()
+	// loginthrottle.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	loginthrottle.KeyValidator = func() func(string) error {
+		validators := loginthrottleDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// loginthrottleDescFailures is the schema descriptor for failures field.
+	loginthrottleDescFailures := loginthrottleFields[1].Descriptor()
+	// loginthrottle.DefaultFailures holds the default value on creation for the failures field.
+	loginthrottle.DefaultFailures = loginthrottleDescFailures.Default.(int)
+	// loginthrottleDescLastFailureAt is the schema descriptor for last_failure_at field.
+	loginthrottleDescLastFailureAt := loginthrottleFields[2].Descriptor()
+	// loginthrottle.DefaultLastFailureAt holds the default value on creation for the last_failure_at field.
+	loginthrottle.DefaultLastFailureAt = loginthrottleDescLastFailureAt.Default.(func() time.Time)
+	// loginthrottleDescUnlockTokenHash is the schema descriptor for unlock_token_hash field.
+	loginthrottleDescUnlockTokenHash := loginthrottleFields[4].Descriptor()
+	// loginthrottle.UnlockTokenHashValidator is a validator for the "unlock_token_hash" field. It is called by the builders before save.
+	loginthrottle.UnlockTokenHashValidator = loginthrottleDescUnlockTokenHash.Validators[0].(func(string) error)
+	// loginthrottleDescID is the schema descriptor for id field.
+	loginthrottleDescID := loginthrottleMixinFields0[0].Descriptor()
+	// loginthrottle.DefaultID holds the default value on creation for the id field.
+	loginthrottle.DefaultID = loginthrottleDescID.Default.(func() uuid.UUID)
+	mfachallengeMixin := schema.MFAChallenge{}.Mixin()
+	mfachallengeMixinFields0 := mfachallengeMixin[0].Fields()
+	_ = mfachallengeMixinFields0
+	mfachallengeFields := schema.MFAChallenge{}.Fields()
+	_ = mfachallengeFields
+	// mfachallengeDescCreatedAt is the schema descriptor for created_at field.
+	mfachallengeDescCreatedAt := mfachallengeMixinFields0[1].Descriptor()
+	// mfachallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	mfachallenge.DefaultCreatedAt = mfachallengeDescCreatedAt.Default.(func() time.Time)
+	// mfachallengeDescUpdatedAt is the schema descriptor for updated_at field.
+	mfachallengeDescUpdatedAt := mfachallengeMixinFields0[2].Descriptor()
+	// mfachallenge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	mfachallenge.DefaultUpdatedAt = mfachallengeDescUpdatedAt.Default.(func() time.Time)
+	// mfachallenge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	mfachallenge.UpdateDefaultUpdatedAt = mfachallengeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// mfachallengeDescTokenHash is the schema descriptor for token_hash field.
+	mfachallengeDescTokenHash := mfachallengeFields[0].Descriptor()
+	// mfachallenge.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	mfachallenge.TokenHashValidator = func() func(string) error {
+		validators := mfachallengeDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// mfachallengeDescAttempts is the schema descriptor for attempts field.
+	mfachallengeDescAttempts := mfachallengeFields[2].Descriptor()
+	// mfachallenge.DefaultAttempts holds the default value on creation for the attempts field.
+	mfachallenge.DefaultAttempts = mfachallengeDescAttempts.Default.(int)
+	// mfachallengeDescExpiresAt is the schema descriptor for expires_at field.
+	mfachallengeDescExpiresAt := mfachallengeFields[3].Descriptor()
+	// mfachallenge.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	mfachallenge.DefaultExpiresAt = mfachallengeDescExpiresAt.Default.(func() time.Time)
+	// mfachallengeDescID is the schema descriptor for id field.
+	mfachallengeDescID := mfachallengeMixinFields0[0].Descriptor()
+	// mfachallenge.DefaultID holds the default value on creation for the id field.
+	mfachallenge.DefaultID = mfachallengeDescID.Default.(func() uuid.UUID)
+	magiclinkMixin := schema.MagicLink{}.Mixin()
+	magiclinkMixinFields0 := magiclinkMixin[0].Fields()
+	_ = magiclinkMixinFields0
+	magiclinkFields := schema.MagicLink{}.Fields()
+	_ = magiclinkFields
+	// magiclinkDescCreatedAt is the schema descriptor for created_at field.
+	magiclinkDescCreatedAt := magiclinkMixinFields0[1].Descriptor()
+	// magiclink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	magiclink.DefaultCreatedAt = magiclinkDescCreatedAt.Default.(func() time.Time)
+	// magiclinkDescUpdatedAt is the schema descriptor for updated_at field.
+	magiclinkDescUpdatedAt := magiclinkMixinFields0[2].Descriptor()
+	// magiclink.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	magiclink.DefaultUpdatedAt = magiclinkDescUpdatedAt.Default.(func() time.Time)
+	// magiclink.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	magiclink.UpdateDefaultUpdatedAt = magiclinkDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// magiclinkDescTokenHash is the schema descriptor for token_hash field.
+	magiclinkDescTokenHash := magiclinkFields[0].Descriptor()
+	// magiclink.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	magiclink.TokenHashValidator = func() func(string) error {
+		validators := magiclinkDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// magiclinkDescPollTokenHash is the schema descriptor for poll_token_hash field.
+	magiclinkDescPollTokenHash := magiclinkFields[1].Descriptor()
+	// magiclink.PollTokenHashValidator is a validator for the "poll_token_hash" field. It is called by the builders before save.
+	magiclink.PollTokenHashValidator = func() func(string) error {
+		validators := magiclinkDescPollTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(poll_token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(poll_token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// magiclinkDescRedirectTo is the schema descriptor for redirect_to field.
+	magiclinkDescRedirectTo := magiclinkFields[2].Descriptor()
+	// magiclink.RedirectToValidator is a validator for the "redirect_to" field. It is called by the builders before save.
+	magiclink.RedirectToValidator = magiclinkDescRedirectTo.Validators[0].(func(string) error)
+	// magiclinkDescSameDevice is the schema descriptor for same_device field.
+	magiclinkDescSameDevice := magiclinkFields[3].Descriptor()
+	// magiclink.DefaultSameDevice holds the default value on creation for the same_device field.
+	magiclink.DefaultSameDevice = magiclinkDescSameDevice.Default.(bool)
+	// magiclinkDescExpiresAt is the schema descriptor for expires_at field.
+	magiclinkDescExpiresAt := magiclinkFields[5].Descriptor()
+	// magiclink.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	magiclink.DefaultExpiresAt = magiclinkDescExpiresAt.Default.(func() time.Time)
+	// magiclinkDescID is the schema descriptor for id field.
+	magiclinkDescID := magiclinkMixinFields0[0].Descriptor()
+	// magiclink.DefaultID holds the default value on creation for the id field.
+	magiclink.DefaultID = magiclinkDescID.Default.(func() uuid.UUID)
+	oauthflowMixin := schema.OAuthFlow{}.Mixin()
+	oauthflowMixinFields0 := oauthflowMixin[0].Fields()
+	_ = oauthflowMixinFields0
+	oauthflowFields := schema.OAuthFlow{}.Fields()
+	_ = oauthflowFields
+	// oauthflowDescCreatedAt is the schema descriptor for created_at field.
+	oauthflowDescCreatedAt := oauthflowMixinFields0[1].Descriptor()
+	// oauthflow.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthflow.DefaultCreatedAt = oauthflowDescCreatedAt.Default.(func() time.Time)
+	// oauthflowDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthflowDescUpdatedAt := oauthflowMixinFields0[2].Descriptor()
+	// oauthflow.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthflow.DefaultUpdatedAt = oauthflowDescUpdatedAt.Default.(func() time.Time)
+	// oauthflow.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthflow.UpdateDefaultUpdatedAt = oauthflowDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// oauthflowDescStateHash is the schema descriptor for state_hash field.
+	oauthflowDescStateHash := oauthflowFields[0].Descriptor()
+	// oauthflow.StateHashValidator is a validator for the "state_hash" field. It is called by the builders before save.
+	oauthflow.StateHashValidator = func() func(string) error {
+		validators := oauthflowDescStateHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(state_hash string) error {
+			for _, fn := range fns {
+				if err := fn(state_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthflowDescProvider is the schema descriptor for provider field.
+	oauthflowDescProvider := oauthflowFields[1].Descriptor()
+	// oauthflow.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	oauthflow.ProviderValidator = func() func(string) error {
+		validators := oauthflowDescProvider.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(provider string) error {
+			for _, fn := range fns {
+				if err := fn(provider); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthflowDescCodeVerifier is the schema descriptor for code_verifier field.
+	oauthflowDescCodeVerifier := oauthflowFields[3].Descriptor()
+	// oauthflow.CodeVerifierValidator is a validator for the "code_verifier" field. It is called by the builders before save.
+	oauthflow.CodeVerifierValidator = oauthflowDescCodeVerifier.Validators[0].(func(string) error)
+	// oauthflowDescNonce is the schema descriptor for nonce field.
+	oauthflowDescNonce := oauthflowFields[4].Descriptor()
+	// oauthflow.NonceValidator is a validator for the "nonce" field. It is called by the builders before save.
+	oauthflow.NonceValidator = func() func(string) error {
+		validators := oauthflowDescNonce.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(nonce string) error {
+			for _, fn := range fns {
+				if err := fn(nonce); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// oauthflowDescReturnTo is the schema descriptor for return_to field.
+	oauthflowDescReturnTo := oauthflowFields[5].Descriptor()
+	// oauthflow.ReturnToValidator is a validator for the "return_to" field. It is called by the builders before save.
+	oauthflow.ReturnToValidator = oauthflowDescReturnTo.Validators[0].(func(string) error)
+	// oauthflowDescExpiresAt is the schema descriptor for expires_at field.
+	oauthflowDescExpiresAt := oauthflowFields[6].Descriptor()
+	// oauthflow.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	oauthflow.DefaultExpiresAt = oauthflowDescExpiresAt.Default.(func() time.Time)
+	// oauthflowDescID is the schema descriptor for id field.
+	oauthflowDescID := oauthflowMixinFields0[0].Descriptor()
+	// oauthflow.DefaultID holds the default value on creation for the id field.
+	oauthflow.DefaultID = oauthflowDescID.Default.(func() uuid.UUID)
 	otpMixin := schema.OTP{}.Mixin()
 	otpMixinFields0 := otpMixin[0].Fields()
 	_ = otpMixinFields0
@@ -60,38 +529,79 @@ func init() {
 	otp.DefaultUpdatedAt = otpDescUpdatedAt.Default.(func() time.Time)
 	// otp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	otp.UpdateDefaultUpdatedAt = otpDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// otpDescCode is the schema descriptor for code field.
-	otpDescCode := otpFields[0].Descriptor()
-	// otp.DefaultCode holds the default value on creation for the code field.
-	otp.DefaultCode = otpDescCode.Default.(func() string)
-	// otp.CodeValidator is a validator for the "code" field. It is called by the builders before save.
-	otp.CodeValidator = func() func(string) error {
-		validators := otpDescCode.Validators
+	// otpDescCodeHash is the schema descriptor for code_hash field.
+	otpDescCodeHash := otpFields[0].Descriptor()
+	// otp.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	otp.CodeHashValidator = otpDescCodeHash.Validators[0].(func(string) error)
+	// otpDescUsed is the schema descriptor for used field.
+	otpDescUsed := otpFields[2].Descriptor()
+	// otp.DefaultUsed holds the default value on creation for the used field.
+	otp.DefaultUsed = otpDescUsed.Default.(bool)
+	// otpDescAttempts is the schema descriptor for attempts field.
+	otpDescAttempts := otpFields[3].Descriptor()
+	// otp.DefaultAttempts holds the default value on creation for the attempts field.
+	otp.DefaultAttempts = otpDescAttempts.Default.(int)
+	// otpDescID is the schema descriptor for id field.
+	otpDescID := otpMixinFields0[0].Descriptor()
+	// otp.DefaultID holds the default value on creation for the id field.
+	otp.DefaultID = otpDescID.Default.(func() uuid.UUID)
+	passkeyMixin := schema.Passkey{}.Mixin()
+	passkeyMixinFields0 := passkeyMixin[0].Fields()
+	_ = passkeyMixinFields0
+	passkeyFields := schema.Passkey{}.Fields()
+	_ = passkeyFields
+	// passkeyDescCreatedAt is the schema descriptor for created_at field.
+	passkeyDescCreatedAt := passkeyMixinFields0[1].Descriptor()
+	// passkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passkey.DefaultCreatedAt = passkeyDescCreatedAt.Default.(func() time.Time)
+	// passkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	passkeyDescUpdatedAt := passkeyMixinFields0[2].Descriptor()
+	// passkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passkey.DefaultUpdatedAt = passkeyDescUpdatedAt.Default.(func() time.Time)
+	// passkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passkey.UpdateDefaultUpdatedAt = passkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// passkeyDescName is the schema descriptor for name field.
+	passkeyDescName := passkeyFields[0].Descriptor()
+	// passkey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	passkey.NameValidator = func() func(string) error {
+		validators := passkeyDescName.Validators
 		fns := [...]func(string) error{
 			validators[0].(func(string) error),
 			validators[1].(func(string) error),
 		}
-		return func(code string) error {
+		return func(name string) error {
 			for _, fn := range fns {
-				if err := fn(code); err != nil {
+				if err := fn(name); err != nil {
 					return err
 				}
 			}
 			return nil
 		}
 	}()
-	// otpDescUsed is the schema descriptor for used field.
-	otpDescUsed := otpFields[2].Descriptor()
-	// otp.DefaultUsed holds the default value on creation for the used field.
-	otp.DefaultUsed = otpDescUsed.Default.(bool)
-	// otpDescExpiresAt is the schema descriptor for expires_at field.
-	otpDescExpiresAt := otpFields[3].Descriptor()
-	// otp.DefaultExpiresAt holds the default value on creation for the expires_at field.
-	otp.DefaultExpiresAt = otpDescExpiresAt.Default.(func() time.Time)
-	// otpDescID is the schema descriptor for id field.
-	otpDescID := otpMixinFields0[0].Descriptor()
-	// otp.DefaultID holds the default value on creation for the id field.
-	otp.DefaultID = otpDescID.Default.(func() uuid.UUID)
+	// passkeyDescCredentialID is the schema descriptor for credential_id field.
+	passkeyDescCredentialID := passkeyFields[1].Descriptor()
+	// passkey.CredentialIDValidator is a validator for the "credential_id" field. It is called by the builders before save.
+	passkey.CredentialIDValidator = passkeyDescCredentialID.Validators[0].(func([]byte) error)
+	// passkeyDescPublicKey is the schema descriptor for public_key field.
+	passkeyDescPublicKey := passkeyFields[2].Descriptor()
+	// passkey.PublicKeyValidator is a validator for the "public_key" field. It is called by the builders before save.
+	passkey.PublicKeyValidator = passkeyDescPublicKey.Validators[0].(func([]byte) error)
+	// passkeyDescSignCount is the schema descriptor for sign_count field.
+	passkeyDescSignCount := passkeyFields[6].Descriptor()
+	// passkey.DefaultSignCount holds the default value on creation for the sign_count field.
+	passkey.DefaultSignCount = passkeyDescSignCount.Default.(uint32)
+	// passkeyDescBackupEligible is the schema descriptor for backup_eligible field.
+	passkeyDescBackupEligible := passkeyFields[7].Descriptor()
+	// passkey.DefaultBackupEligible holds the default value on creation for the backup_eligible field.
+	passkey.DefaultBackupEligible = passkeyDescBackupEligible.Default.(bool)
+	// passkeyDescBackupState is the schema descriptor for backup_state field.
+	passkeyDescBackupState := passkeyFields[8].Descriptor()
+	// passkey.DefaultBackupState holds the default value on creation for the backup_state field.
+	passkey.DefaultBackupState = passkeyDescBackupState.Default.(bool)
+	// passkeyDescID is the schema descriptor for id field.
+	passkeyDescID := passkeyMixinFields0[0].Descriptor()
+	// passkey.DefaultID holds the default value on creation for the id field.
+	passkey.DefaultID = passkeyDescID.Default.(func() uuid.UUID)
 	profileMixin := schema.Profile{}.Mixin()
 	profileMixinFields0 := profileMixin[0].Fields()
 	_ = profileMixinFields0
@@ -125,10 +635,145 @@ func init() {
 			return nil
 		}
 	}()
+	// profileDescAvatarURL is the schema descriptor for avatar_url field.
+	profileDescAvatarURL := profileFields[2].Descriptor()
+	// profile.AvatarURLValidator is a validator for the "avatar_url" field. It is called by the builders before save.
+	profile.AvatarURLValidator = profileDescAvatarURL.Validators[0].(func(string) error)
+	// profileDescAvatarKey is the schema descriptor for avatar_key field.
+	profileDescAvatarKey := profileFields[4].Descriptor()
+	// profile.AvatarKeyValidator is a validator for the "avatar_key" field. It is called by the builders before save.
+	profile.AvatarKeyValidator = profileDescAvatarKey.Validators[0].(func(string) error)
 	// profileDescID is the schema descriptor for id field.
 	profileDescID := profileMixinFields0[0].Descriptor()
 	// profile.DefaultID holds the default value on creation for the id field.
 	profile.DefaultID = profileDescID.Default.(func() uuid.UUID)
+	ratelimitbucketMixin := schema.RateLimitBucket{}.Mixin()
+	ratelimitbucketMixinFields0 := ratelimitbucketMixin[0].Fields()
+	_ = ratelimitbucketMixinFields0
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescCreatedAt is the schema descriptor for created_at field.
+	ratelimitbucketDescCreatedAt := ratelimitbucketMixinFields0[1].Descriptor()
+	// ratelimitbucket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ratelimitbucket.DefaultCreatedAt = ratelimitbucketDescCreatedAt.Default.(func() time.Time)
+	// ratelimitbucketDescUpdatedAt is the schema descriptor for updated_at field.
+	ratelimitbucketDescUpdatedAt := ratelimitbucketMixinFields0[2].Descriptor()
+	// ratelimitbucket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratelimitbucket.DefaultUpdatedAt = ratelimitbucketDescUpdatedAt.Default.(func() time.Time)
+	// ratelimitbucket.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ratelimitbucket.UpdateDefaultUpdatedAt = ratelimitbucketDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ratelimitbucketDescKey is the schema descriptor for key field.
+	ratelimitbucketDescKey := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	ratelimitbucket.KeyValidator = func() func(string) error {
+		validators := ratelimitbucketDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// ratelimitbucketDescValue is the schema descriptor for value field.
+	ratelimitbucketDescValue := ratelimitbucketFields[1].Descriptor()
+	// ratelimitbucket.DefaultValue holds the default value on creation for the value field.
+	ratelimitbucket.DefaultValue = ratelimitbucketDescValue.Default.(float64)
+	// ratelimitbucketDescPrevious is the schema descriptor for previous field.
+	ratelimitbucketDescPrevious := ratelimitbucketFields[2].Descriptor()
+	// ratelimitbucket.DefaultPrevious holds the default value on creation for the previous field.
+	ratelimitbucket.DefaultPrevious = ratelimitbucketDescPrevious.Default.(float64)
+	// ratelimitbucketDescVersion is the schema descriptor for version field.
+	ratelimitbucketDescVersion := ratelimitbucketFields[4].Descriptor()
+	// ratelimitbucket.DefaultVersion holds the default value on creation for the version field.
+	ratelimitbucket.DefaultVersion = ratelimitbucketDescVersion.Default.(int)
+	// ratelimitbucketDescID is the schema descriptor for id field.
+	ratelimitbucketDescID := ratelimitbucketMixinFields0[0].Descriptor()
+	// ratelimitbucket.DefaultID holds the default value on creation for the id field.
+	ratelimitbucket.DefaultID = ratelimitbucketDescID.Default.(func() uuid.UUID)
+	recoverycodeMixin := schema.RecoveryCode{}.Mixin()
+	recoverycodeMixinFields0 := recoverycodeMixin[0].Fields()
+	_ = recoverycodeMixinFields0
+	recoverycodeFields := schema.RecoveryCode{}.Fields()
+	_ = recoverycodeFields
+	// recoverycodeDescCreatedAt is the schema descriptor for created_at field.
+	recoverycodeDescCreatedAt := recoverycodeMixinFields0[1].Descriptor()
+	// recoverycode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recoverycode.DefaultCreatedAt = recoverycodeDescCreatedAt.Default.(func() time.Time)
+	// recoverycodeDescUpdatedAt is the schema descriptor for updated_at field.
+	recoverycodeDescUpdatedAt := recoverycodeMixinFields0[2].Descriptor()
+	// recoverycode.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recoverycode.DefaultUpdatedAt = recoverycodeDescUpdatedAt.Default.(func() time.Time)
+	// recoverycode.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recoverycode.UpdateDefaultUpdatedAt = recoverycodeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recoverycodeDescCodeHash is the schema descriptor for code_hash field.
+	recoverycodeDescCodeHash := recoverycodeFields[0].Descriptor()
+	// recoverycode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	recoverycode.CodeHashValidator = func() func(string) error {
+		validators := recoverycodeDescCodeHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(code_hash string) error {
+			for _, fn := range fns {
+				if err := fn(code_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// recoverycodeDescID is the schema descriptor for id field.
+	recoverycodeDescID := recoverycodeMixinFields0[0].Descriptor()
+	// recoverycode.DefaultID holds the default value on creation for the id field.
+	recoverycode.DefaultID = recoverycodeDescID.Default.(func() uuid.UUID)
+	refreshtokenMixin := schema.RefreshToken{}.Mixin()
+	refreshtokenMixinFields0 := refreshtokenMixin[0].Fields()
+	_ = refreshtokenMixinFields0
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenMixinFields0[1].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	// refreshtokenDescUpdatedAt is the schema descriptor for updated_at field.
+	refreshtokenDescUpdatedAt := refreshtokenMixinFields0[2].Descriptor()
+	// refreshtoken.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	refreshtoken.DefaultUpdatedAt = refreshtokenDescUpdatedAt.Default.(func() time.Time)
+	// refreshtoken.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	refreshtoken.UpdateDefaultUpdatedAt = refreshtokenDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = func() func(string) error {
+		validators := refreshtokenDescTokenHash.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(token_hash string) error {
+			for _, fn := range fns {
+				if err := fn(token_hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// refreshtokenDescExpiresAt is the schema descriptor for expires_at field.
+	refreshtokenDescExpiresAt := refreshtokenFields[1].Descriptor()
+	// refreshtoken.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	refreshtoken.DefaultExpiresAt = refreshtokenDescExpiresAt.Default.(func() time.Time)
+	// refreshtokenDescID is the schema descriptor for id field.
+	refreshtokenDescID := refreshtokenMixinFields0[0].Descriptor()
+	// refreshtoken.DefaultID holds the default value on creation for the id field.
+	refreshtoken.DefaultID = refreshtokenDescID.Default.(func() uuid.UUID)
 	sessionMixin := schema.Session{}.Mixin()
 	sessionMixinFields0 := sessionMixin[0].Fields()
 	_ = sessionMixinFields0
@@ -144,14 +789,110 @@ func init() {
 	session.DefaultUpdatedAt = sessionDescUpdatedAt.Default.(func() time.Time)
 	// session.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	session.UpdateDefaultUpdatedAt = sessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// sessionDescTokenHash is the schema descriptor for token_hash field.
+	sessionDescTokenHash := sessionFields[0].Descriptor()
+	// session.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	session.TokenHashValidator = sessionDescTokenHash.Validators[0].(func(string) error)
 	// sessionDescExpires is the schema descriptor for expires field.
-	sessionDescExpires := sessionFields[0].Descriptor()
+	sessionDescExpires := sessionFields[1].Descriptor()
 	// session.DefaultExpires holds the default value on creation for the expires field.
 	session.DefaultExpires = sessionDescExpires.Default.(func() time.Time)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[2].Descriptor()
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescDevice is the schema descriptor for device field.
+	sessionDescDevice := sessionFields[3].Descriptor()
+	// session.DeviceValidator is a validator for the "device" field. It is called by the builders before save.
+	session.DeviceValidator = sessionDescDevice.Validators[0].(func(string) error)
+	// sessionDescBrowser is the schema descriptor for browser field.
+	sessionDescBrowser := sessionFields[4].Descriptor()
+	// session.BrowserValidator is a validator for the "browser" field. It is called by the builders before save.
+	session.BrowserValidator = sessionDescBrowser.Validators[0].(func(string) error)
+	// sessionDescOs is the schema descriptor for os field.
+	sessionDescOs := sessionFields[5].Descriptor()
+	// session.OsValidator is a validator for the "os" field. It is called by the builders before save.
+	session.OsValidator = sessionDescOs.Validators[0].(func(string) error)
+	// sessionDescIPAddress is the schema descriptor for ip_address field.
+	sessionDescIPAddress := sessionFields[6].Descriptor()
+	// session.IPAddressValidator is a validator for the "ip_address" field. It is called by the builders before save.
+	session.IPAddressValidator = sessionDescIPAddress.Validators[0].(func(string) error)
+	// sessionDescLastSeenAt is the schema descriptor for last_seen_at field.
+	sessionDescLastSeenAt := sessionFields[7].Descriptor()
+	// session.DefaultLastSeenAt holds the default value on creation for the last_seen_at field.
+	session.DefaultLastSeenAt = sessionDescLastSeenAt.Default.(func() time.Time)
+	// sessionDescAuthenticatedAt is the schema descriptor for authenticated_at field.
+	sessionDescAuthenticatedAt := sessionFields[8].Descriptor()
+	// session.DefaultAuthenticatedAt holds the default value on creation for the authenticated_at field.
+	session.DefaultAuthenticatedAt = sessionDescAuthenticatedAt.Default.(func() time.Time)
 	// sessionDescID is the schema descriptor for id field.
 	sessionDescID := sessionMixinFields0[0].Descriptor()
 	// session.DefaultID holds the default value on creation for the id field.
 	session.DefaultID = sessionDescID.Default.(func() uuid.UUID)
+	totpcredentialMixin := schema.TOTPCredential{}.Mixin()
+	totpcredentialMixinFields0 := totpcredentialMixin[0].Fields()
+	_ = totpcredentialMixinFields0
+	totpcredentialFields := schema.TOTPCredential{}.Fields()
+	_ = totpcredentialFields
+	// totpcredentialDescCreatedAt is the schema descriptor for created_at field.
+	totpcredentialDescCreatedAt := totpcredentialMixinFields0[1].Descriptor()
+	// totpcredential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	totpcredential.DefaultCreatedAt = totpcredentialDescCreatedAt.Default.(func() time.Time)
+	// totpcredentialDescUpdatedAt is the schema descriptor for updated_at field.
+	totpcredentialDescUpdatedAt := totpcredentialMixinFields0[2].Descriptor()
+	// totpcredential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	totpcredential.DefaultUpdatedAt = totpcredentialDescUpdatedAt.Default.(func() time.Time)
+	// totpcredential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	totpcredential.UpdateDefaultUpdatedAt = totpcredentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// totpcredentialDescSecret is the schema descriptor for secret field.
+	totpcredentialDescSecret := totpcredentialFields[0].Descriptor()
+	// totpcredential.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	totpcredential.SecretValidator = func() func(string) error {
+		validators := totpcredentialDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// totpcredentialDescLastUsedStep is the schema descriptor for last_used_step field.
+	totpcredentialDescLastUsedStep := totpcredentialFields[2].Descriptor()
+	// totpcredential.DefaultLastUsedStep holds the default value on creation for the last_used_step field.
+	totpcredential.DefaultLastUsedStep = totpcredentialDescLastUsedStep.Default.(int64)
+	// totpcredentialDescID is the schema descriptor for id field.
+	totpcredentialDescID := totpcredentialMixinFields0[0].Descriptor()
+	// totpcredential.DefaultID holds the default value on creation for the id field.
+	totpcredential.DefaultID = totpcredentialDescID.Default.(func() uuid.UUID)
+	tokenfamilyMixin := schema.TokenFamily{}.Mixin()
+	tokenfamilyMixinFields0 := tokenfamilyMixin[0].Fields()
+	_ = tokenfamilyMixinFields0
+	tokenfamilyFields := schema.TokenFamily{}.Fields()
+	_ = tokenfamilyFields
+	// tokenfamilyDescCreatedAt is the schema descriptor for created_at field.
+	tokenfamilyDescCreatedAt := tokenfamilyMixinFields0[1].Descriptor()
+	// tokenfamily.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tokenfamily.DefaultCreatedAt = tokenfamilyDescCreatedAt.Default.(func() time.Time)
+	// tokenfamilyDescUpdatedAt is the schema descriptor for updated_at field.
+	tokenfamilyDescUpdatedAt := tokenfamilyMixinFields0[2].Descriptor()
+	// tokenfamily.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tokenfamily.DefaultUpdatedAt = tokenfamilyDescUpdatedAt.Default.(func() time.Time)
+	// tokenfamily.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tokenfamily.UpdateDefaultUpdatedAt = tokenfamilyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tokenfamilyDescAuthenticatedAt is the schema descriptor for authenticated_at field.
+	tokenfamilyDescAuthenticatedAt := tokenfamilyFields[2].Descriptor()
+	// tokenfamily.DefaultAuthenticatedAt holds the default value on creation for the authenticated_at field.
+	tokenfamily.DefaultAuthenticatedAt = tokenfamilyDescAuthenticatedAt.Default.(func() time.Time)
+	// tokenfamilyDescID is the schema descriptor for id field.
+	tokenfamilyDescID := tokenfamilyMixinFields0[0].Descriptor()
+	// tokenfamily.DefaultID holds the default value on creation for the id field.
+	tokenfamily.DefaultID = tokenfamilyDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -201,4 +942,31 @@ func init() {
 	userDescID := userMixinFields0[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	webauthnchallengeMixin := schema.WebAuthnChallenge{}.Mixin()
+	webauthnchallengeMixinFields0 := webauthnchallengeMixin[0].Fields()
+	_ = webauthnchallengeMixinFields0
+	webauthnchallengeFields := schema.WebAuthnChallenge{}.Fields()
+	_ = webauthnchallengeFields
+	// webauthnchallengeDescCreatedAt is the schema descriptor for created_at field.
+	webauthnchallengeDescCreatedAt := webauthnchallengeMixinFields0[1].Descriptor()
+	// webauthnchallenge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webauthnchallenge.DefaultCreatedAt = webauthnchallengeDescCreatedAt.Default.(func() time.Time)
+	// webauthnchallengeDescUpdatedAt is the schema descriptor for updated_at field.
+	webauthnchallengeDescUpdatedAt := webauthnchallengeMixinFields0[2].Descriptor()
+	// webauthnchallenge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webauthnchallenge.DefaultUpdatedAt = webauthnchallengeDescUpdatedAt.Default.(func() time.Time)
+	// webauthnchallenge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webauthnchallenge.UpdateDefaultUpdatedAt = webauthnchallengeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webauthnchallengeDescChallenge is the schema descriptor for challenge field.
+	webauthnchallengeDescChallenge := webauthnchallengeFields[0].Descriptor()
+	// webauthnchallenge.ChallengeValidator is a validator for the "challenge" field. It is called by the builders before save.
+	webauthnchallenge.ChallengeValidator = webauthnchallengeDescChallenge.Validators[0].(func(string) error)
+	// webauthnchallengeDescExpiresAt is the schema descriptor for expires_at field.
+	webauthnchallengeDescExpiresAt := webauthnchallengeFields[3].Descriptor()
+	// webauthnchallenge.DefaultExpiresAt holds the default value on creation for the expires_at field.
+	webauthnchallenge.DefaultExpiresAt = webauthnchallengeDescExpiresAt.Default.(func() time.Time)
+	// webauthnchallengeDescID is the schema descriptor for id field.
+	webauthnchallengeDescID := webauthnchallengeMixinFields0[0].Descriptor()
+	// webauthnchallenge.DefaultID holds the default value on creation for the id field.
+	webauthnchallenge.DefaultID = webauthnchallengeDescID.Default.(func() uuid.UUID)
 }
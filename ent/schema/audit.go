@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent records a security relevant change to a user's account
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("action").
+			Values("account_linked", "account_unlinked").
+			Immutable(),
+		// What the action applied to, e.g. the provider of a linked account
+		field.JSON("details", map[string]string{}).
+			Optional().
+			Immutable(),
+		field.String("ip_address").
+			Optional().
+			Immutable().
+			MaxLen(45),
+		field.String("user_agent").
+			Optional().
+			Immutable().
+			MaxLen(512),
+	}
+}
+
+func (AuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("audit_events").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+	}
+}
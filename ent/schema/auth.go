@@ -1,8 +1,7 @@
 package schema
 
 import (
-	"fmt"
-	"math/rand"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
@@ -22,9 +21,41 @@ func (Session) Mixin() []ent.Mixin {
 
 func (Session) Fields() []ent.Field {
 	return []ent.Field{
-		field.Time("expires").
+		// SHA-256 of the token handed to the client. Optional only so that
+		// sessions predating hashed tokens can exist until they are purged.
+		field.String("token_hash").
+			Optional().
+			Unique().
 			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		// Slid forward on activity, see services.TouchSession
+		field.Time("expires").
 			Default(GetTokenExpiration),
+		field.String("user_agent").
+			Optional().
+			MaxLen(512),
+		field.String("device").
+			Optional().
+			MaxLen(255),
+		field.String("browser").
+			Optional().
+			MaxLen(255),
+		field.String("os").
+			Optional().
+			MaxLen(255),
+		field.String("ip_address").
+			Optional().
+			MaxLen(45),
+		// Optional so the column can be added to existing rows
+		field.Time("last_seen_at").
+			Optional().
+			Default(time.Now),
+		// Last time the user proved their identity on this session, at login or
+		// through re-authentication. Rows predating the column count as stale.
+		field.Time("authenticated_at").
+			Optional().
+			Default(time.Now),
 	}
 }
 
@@ -52,22 +83,24 @@ func (OTP) Mixin() []ent.Mixin {
 
 func (OTP) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("code").
-			NotEmpty().
+		// Salted SHA-256 of the code, see services.GenerateOTP. Optional only so
+		// that plaintext codes from before hashing can be purged on migration.
+		field.String("code_hash").
+			Optional().
 			Immutable().
-			MaxLen(255).
-			DefaultFunc(func() string {
-				// Generate a 6-digit OTP code
-				return fmt.Sprintf("%06d", rand.Intn(1000000))
-			}),
+			MaxLen(64).
+			Sensitive(),
 		field.Enum("type").
-			Values("login", "password_reset").
+			Values("login", "password_reset", "reauthentication", "email_verification", "phone_verification", "email_change", "account_link").
 			Default("login"),
 		field.Bool("used").
 			Default(false),
+		// Wrong guesses so far, the code is burned once config.GetOTPMaxAttempts is reached
+		field.Int("attempts").
+			Default(0),
+		// Set from the per type TTL, see config.GetOTPTTL
 		field.Time("expires_at").
-			Immutable().
-			Default(GetTokenExpiration),
+			Immutable(),
 	}
 }
 
@@ -82,3 +115,147 @@ func (OTP) Edges() []ent.Edge {
 			}),
 	}
 }
+
+// RefreshToken is a long-lived credential exchanged for new access tokens by bearer token clients
+type RefreshToken struct {
+	ent.Schema
+}
+
+func (RefreshToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetRefreshTokenExpiration),
+		// Set once the token has been exchanged for its successor. Presenting
+		// a rotated token again means it was stolen and revokes the family.
+		field.Time("rotated_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.From("family", TokenFamily.Type).
+			Ref("refresh_tokens").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// TokenFamily is the chain of refresh tokens descending from a single login
+type TokenFamily struct {
+	ent.Schema
+}
+
+func (TokenFamily) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (TokenFamily) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Enum("revoke_reason").
+			Values("logout", "reuse_detected", "account_change").
+			Optional().
+			Nillable(),
+		// Same as Session.authenticated_at, for bearer token logins
+		field.Time("authenticated_at").
+			Optional().
+			Default(time.Now),
+	}
+}
+
+func (TokenFamily) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("token_families").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// APIKey is a long-lived personal credential for machine clients, limited to a set of scopes
+type APIKey struct {
+	ent.Schema
+}
+
+func (APIKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		// Public part of the key used for lookup, safe to show in listings
+		field.String("prefix").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(16),
+		field.String("secret_hash").
+			NotEmpty().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.Strings("scopes"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("api_keys").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/mixin"
+	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/google/uuid"
 )
 
@@ -27,6 +28,39 @@ func (BaseMixin) Fields() []ent.Field {
 			UpdateDefault(time.Now),
 	}
 }
+
+// GetTokenExpiration returns the expiry for a freshly issued token, based on
+// the configured session idle timeout
 func GetTokenExpiration() time.Time {
-	return time.Now().Add(time.Hour * 24 * 30)
+	return time.Now().Add(config.GetSessionIdleTimeout())
+}
+
+// GetRefreshTokenExpiration returns the expiry for a freshly issued refresh token
+func GetRefreshTokenExpiration() time.Time {
+	return time.Now().Add(config.GetRefreshTokenTTL())
+}
+
+// GetMFAChallengeExpiration returns the expiry for a pending second factor challenge
+func GetMFAChallengeExpiration() time.Time {
+	return time.Now().Add(config.GetMFAChallengeTTL())
+}
+
+// GetWebAuthnChallengeExpiration returns the expiry for a pending passkey ceremony
+func GetWebAuthnChallengeExpiration() time.Time {
+	return time.Now().Add(config.GetWebAuthnChallengeTTL())
+}
+
+// GetMagicLinkExpiration returns the expiry for a freshly emailed login link
+func GetMagicLinkExpiration() time.Time {
+	return time.Now().Add(config.GetMagicLinkTTL())
+}
+
+// GetAccountLinkExpiration returns the expiry for a provider login waiting to be linked
+func GetAccountLinkExpiration() time.Time {
+	return time.Now().Add(config.GetAccountLinkTTL())
+}
+
+// GetOAuthFlowExpiration returns the expiry for an authorization request sent to a provider
+func GetOAuthFlowExpiration() time.Time {
+	return time.Now().Add(config.GetOAuthFlowTTL())
 }
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ContactChange is a pending change of a user's contact details. The current
+// value stays in use until the new one is confirmed with the code sent to it.
+// Email changes can additionally be reverted from the old address.
+type ContactChange struct {
+	ent.Schema
+}
+
+func (ContactChange) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (ContactChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Values("phone", "email").
+			Immutable(),
+		// The new value, applied to the user once confirmed
+		field.String("value").
+			NotEmpty().
+			Immutable().
+			MaxLen(255),
+		// The value being replaced, restored if the change is reverted
+		field.String("previous_value").
+			Optional().
+			Immutable().
+			MaxLen(255),
+		// Matches the verification code's TTL, see config.GetOTPTTL
+		field.Time("expires_at").
+			Immutable(),
+		// Email changes only. SHA-256 of the token in the notice sent to the old
+		// address, which cancels the change or undoes it once confirmed.
+		field.String("revert_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.Time("revert_expires_at").
+			Optional().
+			Nillable().
+			Immutable(),
+		// Set once the change was applied, the record is kept until the revert link expires
+		field.Time("confirmed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (ContactChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("contact_changes").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AccountLink is a provider login that matched an existing user by email and
+// waits for that user to confirm with their password or an emailed code
+type AccountLink struct {
+	ent.Schema
+}
+
+func (AccountLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (AccountLink) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the token handed to the client that logged in with the provider
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.Enum("type").
+			Values("google", "apple", "github", "microsoft", "gitlab", "oidc").
+			Immutable(),
+		field.String("provider_id").
+			NotEmpty().
+			Immutable().
+			MaxLen(255).
+			Sensitive(),
+		// Wrong passwords so far, the link is dropped once config.GetOTPMaxAttempts is reached
+		field.Int("attempts").
+			Default(0),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetAccountLinkExpiration),
+	}
+}
+
+func (AccountLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("account_links").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		// Set while the link waits for the user's second factor
+		edge.To("mfa_challenge", MFAChallenge.Type).
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// MagicLink is a single-use login link sent by email. The device that requested
+// it holds a separate poll token to pick up the session once the link is clicked.
+type MagicLink struct {
+	ent.Schema
+}
+
+func (MagicLink) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (MagicLink) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the token embedded in the emailed link
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		// SHA-256 of the token handed to the requesting device
+		field.String("poll_token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		// Where the frontend sends the user after logging in, an ALLOWED_ORIGINS URL
+		field.String("redirect_to").
+			Optional().
+			Immutable().
+			MaxLen(2048),
+		// Only create the session on the requesting device. Clicking the link
+		// anywhere else approves the login for that device to pick up by polling.
+		field.Bool("same_device").
+			Default(false).
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "approved", "consumed").
+			Default("pending"),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetMagicLinkExpiration),
+	}
+}
+
+func (MagicLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("magic_links").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
@@ -0,0 +1,131 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// TOTPCredential is the authenticator app secret of a user. It only counts as
+// a second factor once confirmed with a first valid code.
+type TOTPCredential struct {
+	ent.Schema
+}
+
+func (TOTPCredential) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (TOTPCredential) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("secret").
+			NotEmpty().
+			MaxLen(64).
+			Sensitive(),
+		field.Time("confirmed_at").
+			Optional().
+			Nillable(),
+		// Time step of the last accepted code, so a code cannot be replayed
+		field.Int64("last_used_step").
+			Default(0),
+	}
+}
+
+func (TOTPCredential) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("totp_credential").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// RecoveryCode is a single use fallback for a lost authenticator
+type RecoveryCode struct {
+	ent.Schema
+}
+
+func (RecoveryCode) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (RecoveryCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").
+			NotEmpty().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (RecoveryCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recovery_codes").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// MFAChallenge is issued after a successful first factor and exchanged for a
+// session once the second factor is verified
+type MFAChallenge struct {
+	ent.Schema
+}
+
+func (MFAChallenge) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (MFAChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		// The first factor that was completed, for analytics
+		field.Enum("method").
+			Values("password", "otp", "magic_link", "passkey").
+			Immutable(),
+		field.Int("attempts").
+			Default(0),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetMFAChallengeExpiration),
+	}
+}
+
+func (MFAChallenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("mfa_challenges").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		// The provider login to link once the challenge is completed
+		edge.From("account_link", AccountLink.Type).
+			Ref("mfa_challenge").
+			Unique(),
+	}
+}
@@ -0,0 +1,72 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthFlow is an authorization request sent to a provider, redeemed once by
+// the callback carrying its state
+type OAuthFlow struct {
+	ent.Schema
+}
+
+func (OAuthFlow) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (OAuthFlow) Fields() []ent.Field {
+	return []ent.Field{
+		// SHA-256 of the state sent to the provider
+		field.String("state_hash").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		field.String("provider").
+			NotEmpty().
+			Immutable().
+			MaxLen(32),
+		// Linking attaches the identity to the user who started the flow
+		field.Enum("purpose").
+			Values("login", "link").
+			Default("login").
+			Immutable(),
+		// PKCE verifier, empty for providers without PKCE support
+		field.String("code_verifier").
+			Optional().
+			Immutable().
+			MaxLen(128).
+			Sensitive(),
+		// Expected in the ID token, binding it to this flow
+		field.String("nonce").
+			NotEmpty().
+			Immutable().
+			MaxLen(64).
+			Sensitive(),
+		// Where the frontend sends the user afterwards, an ALLOWED_ORIGINS URL
+		field.String("return_to").
+			Optional().
+			Immutable().
+			MaxLen(2048),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetOAuthFlowExpiration),
+	}
+}
+
+func (OAuthFlow) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_flows").
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Passkey is a WebAuthn public key credential registered by a user
+type Passkey struct {
+	ent.Schema
+}
+
+func (Passkey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (Passkey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(100),
+		field.Bytes("credential_id").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Bytes("public_key").
+			NotEmpty().
+			Immutable(),
+		field.String("attestation_type").
+			Optional().
+			Immutable(),
+		field.Strings("transports").
+			Optional(),
+		field.Bytes("aaguid").
+			Optional().
+			Immutable(),
+		// Signature counter of the last assertion, a lower value hints at a cloned authenticator
+		field.Uint32("sign_count").
+			Default(0),
+		field.Bool("backup_eligible").
+			Default(false).
+			Immutable(),
+		field.Bool("backup_state").
+			Default(false),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+func (Passkey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("passkeys").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
+
+// WebAuthnChallenge holds the server side state of a registration or login
+// ceremony between its begin and finish requests
+type WebAuthnChallenge struct {
+	ent.Schema
+}
+
+func (WebAuthnChallenge) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (WebAuthnChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		// The base64url challenge, echoed back by the authenticator in its client data
+		field.String("challenge").
+			NotEmpty().
+			Unique().
+			Immutable(),
+		field.Enum("ceremony").
+			Values("registration", "login").
+			Immutable(),
+		// JSON encoded webauthn.SessionData
+		field.Bytes("session_data").
+			Immutable().
+			Sensitive(),
+		field.Time("expires_at").
+			Immutable().
+			Default(GetWebAuthnChallengeExpiration),
+	}
+}
+
+func (WebAuthnChallenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Only set for registration, passkey logins start without a known user
+		edge.From("user", User.Type).
+			Ref("webauthn_challenges").
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+	}
+}
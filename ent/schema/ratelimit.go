@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitBucket is the shared state of one rate limit key when limits are
+// enforced across replicas, see ratelimit.PostgresStore
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+func (RateLimitBucket) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(512),
+		// Meaning depends on the policy: tokens left, or requests in the current window
+		field.Float("value").
+			Default(0),
+		// Requests in the previous window for sliding window policies
+		field.Float("previous").
+			Default(0),
+		// Last refill, or start of the current window
+		field.Time("stamp"),
+		// Bumped on every take, which is what locks the row, see ratelimit.PostgresStore
+		field.Int("version").
+			Default(0),
+		field.Time("expires_at"),
+	}
+}
+
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
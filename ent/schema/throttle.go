@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// LoginThrottle counts consecutive failed logins for an account identifier or
+// client IP. Kept in the database so limits hold across restarts and replicas.
+type LoginThrottle struct {
+	ent.Schema
+}
+
+func (LoginThrottle) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		BaseMixin{},
+	}
+}
+
+func (LoginThrottle) Fields() []ent.Field {
+	return []ent.Field{
+		// "account:<email or phone>" or "ip:<address>". Accounts are keyed by the
+		// submitted identifier so unknown accounts are throttled the same way.
+		field.String("key").
+			NotEmpty().
+			Unique().
+			Immutable().
+			MaxLen(320),
+		field.Int("failures").
+			Default(0),
+		field.Time("last_failure_at").
+			Default(time.Now),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		// SHA-256 of the token in the unlock email sent when an account locks
+		field.String("unlock_token_hash").
+			Optional().
+			Nillable().
+			Unique().
+			MaxLen(64).
+			Sensitive(),
+	}
+}
@@ -19,17 +19,19 @@ func (User) Mixin() []ent.Mixin {
 
 func (User) Fields() []ent.Field {
 	return []ent.Field{
+		// Changed through services.RequestEmailChange, which confirms the new address first
 		field.String("email").
 			Unique().
 			NotEmpty().
-			MaxLen(255).
-			Immutable(),
+			MaxLen(255),
 		field.Bool("email_verified").
 			Default(false),
 		field.String("phone_number").
 			Optional().
 			Unique().
 			MaxLen(255),
+		// Only set once the number was confirmed with an SMS code, see
+		// services.ConfirmPhoneChange. Unverified numbers can't be used to log in.
 		field.Bool("phone_number_verified").
 			Default(false),
 	}
@@ -54,6 +56,59 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("token_families", TokenFamily.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("api_keys", APIKey.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("totp_credential", TOTPCredential.Type).
+			Unique().
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("recovery_codes", RecoveryCode.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("mfa_challenges", MFAChallenge.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("passkeys", Passkey.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("webauthn_challenges", WebAuthnChallenge.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("magic_links", MagicLink.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("contact_changes", ContactChange.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("account_links", AccountLink.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("audit_events", AuditEvent.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
+		edge.To("oauth_flows", OAuthFlow.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.Cascade,
+			}),
 	}
 }
 
@@ -70,7 +125,7 @@ func (Account) Mixin() []ent.Mixin {
 func (Account) Fields() []ent.Field {
 	return []ent.Field{
 		field.Enum("type").
-			Values("password", "google", "apple"),
+			Values("password", "google", "apple", "github", "microsoft", "gitlab", "oidc"),
 		field.Bytes("password_hash").
 			Optional().
 			MaxLen(255).
@@ -109,6 +164,19 @@ func (Profile) Fields() []ent.Field {
 			MaxLen(255),
 		field.Time("birthday").
 			Optional(),
+		// Picture shown for the user, from their OAuth provider or an upload
+		field.String("avatar_url").
+			Optional().
+			Nillable().
+			MaxLen(2048),
+		// Uploaded avatars by edge length in pixels, avatar_url is one of them
+		field.JSON("avatar_sizes", map[string]string{}).
+			Optional(),
+		// Storage key prefix of the uploaded avatar, to delete it once replaced
+		field.String("avatar_key").
+			Optional().
+			MaxLen(255).
+			Sensitive(),
 	}
 }
 
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/NikSchaefer/go-fiber/ent/totpcredential"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/google/uuid"
+)
+
+// TOTPCredentialUpdate is the builder for updating TOTPCredential entities.
+type TOTPCredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TOTPCredentialMutation
+}
+
+// Where appends a list predicates to the TOTPCredentialUpdate builder.
+func (_u *TOTPCredentialUpdate) Where(ps ...predicate.TOTPCredential) *TOTPCredentialUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TOTPCredentialUpdate) SetUpdatedAt(v time.Time) *TOTPCredentialUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *TOTPCredentialUpdate) SetSecret(v string) *TOTPCredentialUpdate {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *TOTPCredentialUpdate) SetNillableSecret(v *string) *TOTPCredentialUpdate {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *TOTPCredentialUpdate) SetConfirmedAt(v time.Time) *TOTPCredentialUpdate {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *TOTPCredentialUpdate) SetNillableConfirmedAt(v *time.Time) *TOTPCredentialUpdate {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (_u *TOTPCredentialUpdate) ClearConfirmedAt() *TOTPCredentialUpdate {
+	_u.mutation.ClearConfirmedAt()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *TOTPCredentialUpdate) SetLastUsedStep(v int64) *TOTPCredentialUpdate {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *TOTPCredentialUpdate) SetNillableLastUsedStep(v *int64) *TOTPCredentialUpdate {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *TOTPCredentialUpdate) AddLastUsedStep(v int64) *TOTPCredentialUpdate {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TOTPCredentialUpdate) SetUserID(id uuid.UUID) *TOTPCredentialUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TOTPCredentialUpdate) SetUser(v *User) *TOTPCredentialUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TOTPCredentialMutation object of the builder.
+func (_u *TOTPCredentialUpdate) Mutation() *TOTPCredentialMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TOTPCredentialUpdate) ClearUser() *TOTPCredentialUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TOTPCredentialUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TOTPCredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TOTPCredentialUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TOTPCredentialUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TOTPCredentialUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := totpcredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TOTPCredentialUpdate) check() error {
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := totpcredential.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TOTPCredential.secret": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TOTPCredential.user"`)
+	}
+	return nil
+}
+
+func (_u *TOTPCredentialUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpcredential.Table, totpcredential.Columns, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(totpcredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(totpcredential.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpcredential.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(totpcredential.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TOTPCredentialUpdateOne is the builder for updating a single TOTPCredential entity.
+type TOTPCredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TOTPCredentialMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TOTPCredentialUpdateOne) SetUpdatedAt(v time.Time) *TOTPCredentialUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetSecret sets the "secret" field.
+func (_u *TOTPCredentialUpdateOne) SetSecret(v string) *TOTPCredentialUpdateOne {
+	_u.mutation.SetSecret(v)
+	return _u
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (_u *TOTPCredentialUpdateOne) SetNillableSecret(v *string) *TOTPCredentialUpdateOne {
+	if v != nil {
+		_u.SetSecret(*v)
+	}
+	return _u
+}
+
+// SetConfirmedAt sets the "confirmed_at" field.
+func (_u *TOTPCredentialUpdateOne) SetConfirmedAt(v time.Time) *TOTPCredentialUpdateOne {
+	_u.mutation.SetConfirmedAt(v)
+	return _u
+}
+
+// SetNillableConfirmedAt sets the "confirmed_at" field if the given value is not nil.
+func (_u *TOTPCredentialUpdateOne) SetNillableConfirmedAt(v *time.Time) *TOTPCredentialUpdateOne {
+	if v != nil {
+		_u.SetConfirmedAt(*v)
+	}
+	return _u
+}
+
+// ClearConfirmedAt clears the value of the "confirmed_at" field.
+func (_u *TOTPCredentialUpdateOne) ClearConfirmedAt() *TOTPCredentialUpdateOne {
+	_u.mutation.ClearConfirmedAt()
+	return _u
+}
+
+// SetLastUsedStep sets the "last_used_step" field.
+func (_u *TOTPCredentialUpdateOne) SetLastUsedStep(v int64) *TOTPCredentialUpdateOne {
+	_u.mutation.ResetLastUsedStep()
+	_u.mutation.SetLastUsedStep(v)
+	return _u
+}
+
+// SetNillableLastUsedStep sets the "last_used_step" field if the given value is not nil.
+func (_u *TOTPCredentialUpdateOne) SetNillableLastUsedStep(v *int64) *TOTPCredentialUpdateOne {
+	if v != nil {
+		_u.SetLastUsedStep(*v)
+	}
+	return _u
+}
+
+// AddLastUsedStep adds value to the "last_used_step" field.
+func (_u *TOTPCredentialUpdateOne) AddLastUsedStep(v int64) *TOTPCredentialUpdateOne {
+	_u.mutation.AddLastUsedStep(v)
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *TOTPCredentialUpdateOne) SetUserID(id uuid.UUID) *TOTPCredentialUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *TOTPCredentialUpdateOne) SetUser(v *User) *TOTPCredentialUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the TOTPCredentialMutation object of the builder.
+func (_u *TOTPCredentialUpdateOne) Mutation() *TOTPCredentialMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *TOTPCredentialUpdateOne) ClearUser() *TOTPCredentialUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the TOTPCredentialUpdate builder.
+func (_u *TOTPCredentialUpdateOne) Where(ps ...predicate.TOTPCredential) *TOTPCredentialUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TOTPCredentialUpdateOne) Select(field string, fields ...string) *TOTPCredentialUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TOTPCredential entity.
+func (_u *TOTPCredentialUpdateOne) Save(ctx context.Context) (*TOTPCredential, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TOTPCredentialUpdateOne) SaveX(ctx context.Context) *TOTPCredential {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TOTPCredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TOTPCredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TOTPCredentialUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := totpcredential.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TOTPCredentialUpdateOne) check() error {
+	if v, ok := _u.mutation.Secret(); ok {
+		if err := totpcredential.SecretValidator(v); err != nil {
+			return &ValidationError{Name: "secret", err: fmt.Errorf(`ent: validator failed for field "TOTPCredential.secret": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TOTPCredential.user"`)
+	}
+	return nil
+}
+
+func (_u *TOTPCredentialUpdateOne) sqlSave(ctx context.Context) (_node *TOTPCredential, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(totpcredential.Table, totpcredential.Columns, sqlgraph.NewFieldSpec(totpcredential.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TOTPCredential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, totpcredential.FieldID)
+		for _, f := range fields {
+			if !totpcredential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != totpcredential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(totpcredential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Secret(); ok {
+		_spec.SetField(totpcredential.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ConfirmedAt(); ok {
+		_spec.SetField(totpcredential.FieldConfirmedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ConfirmedAtCleared() {
+		_spec.ClearField(totpcredential.FieldConfirmedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastUsedStep(); ok {
+		_spec.SetField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastUsedStep(); ok {
+		_spec.AddField(totpcredential.FieldLastUsedStep, field.TypeInt64, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   totpcredential.UserTable,
+			Columns: []string{totpcredential.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TOTPCredential{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{totpcredential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/NikSchaefer/go-fiber/ent/profile"
+	"github.com/NikSchaefer/go-fiber/ent/totpcredential"
 	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/google/uuid"
 )
@@ -47,9 +48,35 @@ type UserEdges struct {
 	Sessions []*Session `json:"sessions,omitempty"`
 	// Otps holds the value of the otps edge.
 	Otps []*OTP `json:"otps,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
+	// TokenFamilies holds the value of the token_families edge.
+	TokenFamilies []*TokenFamily `json:"token_families,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// TotpCredential holds the value of the totp_credential edge.
+	TotpCredential *TOTPCredential `json:"totp_credential,omitempty"`
+	// RecoveryCodes holds the value of the recovery_codes edge.
+	RecoveryCodes []*RecoveryCode `json:"recovery_codes,omitempty"`
+	// MfaChallenges holds the value of the mfa_challenges edge.
+	MfaChallenges []*MFAChallenge `json:"mfa_challenges,omitempty"`
+	// Passkeys holds the value of the passkeys edge.
+	Passkeys []*Passkey `json:"passkeys,omitempty"`
+	// WebauthnChallenges holds the value of the webauthn_challenges edge.
+	WebauthnChallenges []*WebAuthnChallenge `json:"webauthn_challenges,omitempty"`
+	// MagicLinks holds the value of the magic_links edge.
+	MagicLinks []*MagicLink `json:"magic_links,omitempty"`
+	// ContactChanges holds the value of the contact_changes edge.
+	ContactChanges []*ContactChange `json:"contact_changes,omitempty"`
+	// AccountLinks holds the value of the account_links edge.
+	AccountLinks []*AccountLink `json:"account_links,omitempty"`
+	// AuditEvents holds the value of the audit_events edge.
+	AuditEvents []*AuditEvent `json:"audit_events,omitempty"`
+	// OauthFlows holds the value of the oauth_flows edge.
+	OauthFlows []*OAuthFlow `json:"oauth_flows,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [17]bool
 }
 
 // AccountsOrErr returns the Accounts value or an error if the edge
@@ -90,6 +117,125 @@ func (e UserEdges) OtpsOrErr() ([]*OTP, error) {
 	return nil, &NotLoadedError{edge: "otps"}
 }
 
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[4] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
+// TokenFamiliesOrErr returns the TokenFamilies value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TokenFamiliesOrErr() ([]*TokenFamily, error) {
+	if e.loadedTypes[5] {
+		return e.TokenFamilies, nil
+	}
+	return nil, &NotLoadedError{edge: "token_families"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[6] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// TotpCredentialOrErr returns the TotpCredential value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) TotpCredentialOrErr() (*TOTPCredential, error) {
+	if e.TotpCredential != nil {
+		return e.TotpCredential, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: totpcredential.Label}
+	}
+	return nil, &NotLoadedError{edge: "totp_credential"}
+}
+
+// RecoveryCodesOrErr returns the RecoveryCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecoveryCodesOrErr() ([]*RecoveryCode, error) {
+	if e.loadedTypes[8] {
+		return e.RecoveryCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "recovery_codes"}
+}
+
+// MfaChallengesOrErr returns the MfaChallenges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MfaChallengesOrErr() ([]*MFAChallenge, error) {
+	if e.loadedTypes[9] {
+		return e.MfaChallenges, nil
+	}
+	return nil, &NotLoadedError{edge: "mfa_challenges"}
+}
+
+// PasskeysOrErr returns the Passkeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PasskeysOrErr() ([]*Passkey, error) {
+	if e.loadedTypes[10] {
+		return e.Passkeys, nil
+	}
+	return nil, &NotLoadedError{edge: "passkeys"}
+}
+
+// WebauthnChallengesOrErr returns the WebauthnChallenges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebauthnChallengesOrErr() ([]*WebAuthnChallenge, error) {
+	if e.loadedTypes[11] {
+		return e.WebauthnChallenges, nil
+	}
+	return nil, &NotLoadedError{edge: "webauthn_challenges"}
+}
+
+// MagicLinksOrErr returns the MagicLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MagicLinksOrErr() ([]*MagicLink, error) {
+	if e.loadedTypes[12] {
+		return e.MagicLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "magic_links"}
+}
+
+// ContactChangesOrErr returns the ContactChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ContactChangesOrErr() ([]*ContactChange, error) {
+	if e.loadedTypes[13] {
+		return e.ContactChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "contact_changes"}
+}
+
+// AccountLinksOrErr returns the AccountLinks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccountLinksOrErr() ([]*AccountLink, error) {
+	if e.loadedTypes[14] {
+		return e.AccountLinks, nil
+	}
+	return nil, &NotLoadedError{edge: "account_links"}
+}
+
+// AuditEventsOrErr returns the AuditEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditEventsOrErr() ([]*AuditEvent, error) {
+	if e.loadedTypes[15] {
+		return e.AuditEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_events"}
+}
+
+// OauthFlowsOrErr returns the OauthFlows value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthFlowsOrErr() ([]*OAuthFlow, error) {
+	if e.loadedTypes[16] {
+		return e.OauthFlows, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_flows"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -193,6 +339,71 @@ func (_m *User) QueryOtps() *OTPQuery {
 	return NewUserClient(_m.config).QueryOtps(_m)
 }
 
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (_m *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(_m.config).QueryRefreshTokens(_m)
+}
+
+// QueryTokenFamilies queries the "token_families" edge of the User entity.
+func (_m *User) QueryTokenFamilies() *TokenFamilyQuery {
+	return NewUserClient(_m.config).QueryTokenFamilies(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the User entity.
+func (_m *User) QueryAPIKeys() *APIKeyQuery {
+	return NewUserClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryTotpCredential queries the "totp_credential" edge of the User entity.
+func (_m *User) QueryTotpCredential() *TOTPCredentialQuery {
+	return NewUserClient(_m.config).QueryTotpCredential(_m)
+}
+
+// QueryRecoveryCodes queries the "recovery_codes" edge of the User entity.
+func (_m *User) QueryRecoveryCodes() *RecoveryCodeQuery {
+	return NewUserClient(_m.config).QueryRecoveryCodes(_m)
+}
+
+// QueryMfaChallenges queries the "mfa_challenges" edge of the User entity.
+func (_m *User) QueryMfaChallenges() *MFAChallengeQuery {
+	return NewUserClient(_m.config).QueryMfaChallenges(_m)
+}
+
+// QueryPasskeys queries the "passkeys" edge of the User entity.
+func (_m *User) QueryPasskeys() *PasskeyQuery {
+	return NewUserClient(_m.config).QueryPasskeys(_m)
+}
+
+// QueryWebauthnChallenges queries the "webauthn_challenges" edge of the User entity.
+func (_m *User) QueryWebauthnChallenges() *WebAuthnChallengeQuery {
+	return NewUserClient(_m.config).QueryWebauthnChallenges(_m)
+}
+
+// QueryMagicLinks queries the "magic_links" edge of the User entity.
+func (_m *User) QueryMagicLinks() *MagicLinkQuery {
+	return NewUserClient(_m.config).QueryMagicLinks(_m)
+}
+
+// QueryContactChanges queries the "contact_changes" edge of the User entity.
+func (_m *User) QueryContactChanges() *ContactChangeQuery {
+	return NewUserClient(_m.config).QueryContactChanges(_m)
+}
+
+// QueryAccountLinks queries the "account_links" edge of the User entity.
+func (_m *User) QueryAccountLinks() *AccountLinkQuery {
+	return NewUserClient(_m.config).QueryAccountLinks(_m)
+}
+
+// QueryAuditEvents queries the "audit_events" edge of the User entity.
+func (_m *User) QueryAuditEvents() *AuditEventQuery {
+	return NewUserClient(_m.config).QueryAuditEvents(_m)
+}
+
+// QueryOauthFlows queries the "oauth_flows" edge of the User entity.
+func (_m *User) QueryOauthFlows() *OAuthFlowQuery {
+	return NewUserClient(_m.config).QueryOauthFlows(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
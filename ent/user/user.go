@@ -35,6 +35,32 @@ const (
 	EdgeSessions = "sessions"
 	// EdgeOtps holds the string denoting the otps edge name in mutations.
 	EdgeOtps = "otps"
+	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
+	EdgeRefreshTokens = "refresh_tokens"
+	// EdgeTokenFamilies holds the string denoting the token_families edge name in mutations.
+	EdgeTokenFamilies = "token_families"
+	// EdgeAPIKeys holds the string denoting the api_keys edge name in mutations.
+	EdgeAPIKeys = "api_keys"
+	// EdgeTotpCredential holds the string denoting the totp_credential edge name in mutations.
+	EdgeTotpCredential = "totp_credential"
+	// EdgeRecoveryCodes holds the string denoting the recovery_codes edge name in mutations.
+	EdgeRecoveryCodes = "recovery_codes"
+	// EdgeMfaChallenges holds the string denoting the mfa_challenges edge name in mutations.
+	EdgeMfaChallenges = "mfa_challenges"
+	// EdgePasskeys holds the string denoting the passkeys edge name in mutations.
+	EdgePasskeys = "passkeys"
+	// EdgeWebauthnChallenges holds the string denoting the webauthn_challenges edge name in mutations.
+	EdgeWebauthnChallenges = "webauthn_challenges"
+	// EdgeMagicLinks holds the string denoting the magic_links edge name in mutations.
+	EdgeMagicLinks = "magic_links"
+	// EdgeContactChanges holds the string denoting the contact_changes edge name in mutations.
+	EdgeContactChanges = "contact_changes"
+	// EdgeAccountLinks holds the string denoting the account_links edge name in mutations.
+	EdgeAccountLinks = "account_links"
+	// EdgeAuditEvents holds the string denoting the audit_events edge name in mutations.
+	EdgeAuditEvents = "audit_events"
+	// EdgeOauthFlows holds the string denoting the oauth_flows edge name in mutations.
+	EdgeOauthFlows = "oauth_flows"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// AccountsTable is the table that holds the accounts relation/edge.
@@ -65,6 +91,97 @@ const (
 	OtpsInverseTable = "ot_ps"
 	// OtpsColumn is the table column denoting the otps relation/edge.
 	OtpsColumn = "user_otps"
+	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
+	RefreshTokensColumn = "user_refresh_tokens"
+	// TokenFamiliesTable is the table that holds the token_families relation/edge.
+	TokenFamiliesTable = "token_families"
+	// TokenFamiliesInverseTable is the table name for the TokenFamily entity.
+	// It exists in this package in order to avoid circular dependency with the "tokenfamily" package.
+	TokenFamiliesInverseTable = "token_families"
+	// TokenFamiliesColumn is the table column denoting the token_families relation/edge.
+	TokenFamiliesColumn = "user_token_families"
+	// APIKeysTable is the table that holds the api_keys relation/edge.
+	APIKeysTable = "api_keys"
+	// APIKeysInverseTable is the table name for the APIKey entity.
+	// It exists in this package in order to avoid circular dependency with the "apikey" package.
+	APIKeysInverseTable = "api_keys"
+	// APIKeysColumn is the table column denoting the api_keys relation/edge.
+	APIKeysColumn = "user_api_keys"
+	// TotpCredentialTable is the table that holds the totp_credential relation/edge.
+	TotpCredentialTable = "totp_credentials"
+	// TotpCredentialInverseTable is the table name for the TOTPCredential entity.
+	// It exists in this package in order to avoid circular dependency with the "totpcredential" package.
+	TotpCredentialInverseTable = "totp_credentials"
+	// TotpCredentialColumn is the table column denoting the totp_credential relation/edge.
+	TotpCredentialColumn = "user_totp_credential"
+	// RecoveryCodesTable is the table that holds the recovery_codes relation/edge.
+	RecoveryCodesTable = "recovery_codes"
+	// RecoveryCodesInverseTable is the table name for the RecoveryCode entity.
+	// It exists in this package in order to avoid circular dependency with the "recoverycode" package.
+	RecoveryCodesInverseTable = "recovery_codes"
+	// RecoveryCodesColumn is the table column denoting the recovery_codes relation/edge.
+	RecoveryCodesColumn = "user_recovery_codes"
+	// MfaChallengesTable is the table that holds the mfa_challenges relation/edge.
+	MfaChallengesTable = "mfa_challenges"
+	// MfaChallengesInverseTable is the table name for the MFAChallenge entity.
+	// It exists in this package in order to avoid circular dependency with the "mfachallenge" package.
+	MfaChallengesInverseTable = "mfa_challenges"
+	// MfaChallengesColumn is the table column denoting the mfa_challenges relation/edge.
+	MfaChallengesColumn = "user_mfa_challenges"
+	// PasskeysTable is the table that holds the passkeys relation/edge.
+	PasskeysTable = "passkeys"
+	// PasskeysInverseTable is the table name for the Passkey entity.
+	// It exists in this package in order to avoid circular dependency with the "passkey" package.
+	PasskeysInverseTable = "passkeys"
+	// PasskeysColumn is the table column denoting the passkeys relation/edge.
+	PasskeysColumn = "user_passkeys"
+	// WebauthnChallengesTable is the table that holds the webauthn_challenges relation/edge.
+	WebauthnChallengesTable = "web_authn_challenges"
+	// WebauthnChallengesInverseTable is the table name for the WebAuthnChallenge entity.
+	// It exists in this package in order to avoid circular dependency with the "webauthnchallenge" package.
+	WebauthnChallengesInverseTable = "web_authn_challenges"
+	// WebauthnChallengesColumn is the table column denoting the webauthn_challenges relation/edge.
+	WebauthnChallengesColumn = "user_webauthn_challenges"
+	// MagicLinksTable is the table that holds the magic_links relation/edge.
+	MagicLinksTable = "magic_links"
+	// MagicLinksInverseTable is the table name for the MagicLink entity.
+	// It exists in this package in order to avoid circular dependency with the "magiclink" package.
+	MagicLinksInverseTable = "magic_links"
+	// MagicLinksColumn is the table column denoting the magic_links relation/edge.
+	MagicLinksColumn = "user_magic_links"
+	// ContactChangesTable is the table that holds the contact_changes relation/edge.
+	ContactChangesTable = "contact_changes"
+	// ContactChangesInverseTable is the table name for the ContactChange entity.
+	// It exists in this package in order to avoid circular dependency with the "contactchange" package.
+	ContactChangesInverseTable = "contact_changes"
+	// ContactChangesColumn is the table column denoting the contact_changes relation/edge.
+	ContactChangesColumn = "user_contact_changes"
+	// AccountLinksTable is the table that holds the account_links relation/edge.
+	AccountLinksTable = "account_links"
+	// AccountLinksInverseTable is the table name for the AccountLink entity.
+	// It exists in this package in order to avoid circular dependency with the "accountlink" package.
+	AccountLinksInverseTable = "account_links"
+	// AccountLinksColumn is the table column denoting the account_links relation/edge.
+	AccountLinksColumn = "user_account_links"
+	// AuditEventsTable is the table that holds the audit_events relation/edge.
+	AuditEventsTable = "audit_events"
+	// AuditEventsInverseTable is the table name for the AuditEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "auditevent" package.
+	AuditEventsInverseTable = "audit_events"
+	// AuditEventsColumn is the table column denoting the audit_events relation/edge.
+	AuditEventsColumn = "user_audit_events"
+	// OauthFlowsTable is the table that holds the oauth_flows relation/edge.
+	OauthFlowsTable = "oauth_flows"
+	// OauthFlowsInverseTable is the table name for the OAuthFlow entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthflow" package.
+	OauthFlowsInverseTable = "oauth_flows"
+	// OauthFlowsColumn is the table column denoting the oauth_flows relation/edge.
+	OauthFlowsColumn = "user_oauth_flows"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -193,6 +310,181 @@ func ByOtps(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOtpsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRefreshTokensCount orders the results by refresh_tokens count.
+func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefreshTokensStep(), opts...)
+	}
+}
+
+// ByRefreshTokens orders the results by refresh_tokens terms.
+func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTokenFamiliesCount orders the results by token_families count.
+func ByTokenFamiliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTokenFamiliesStep(), opts...)
+	}
+}
+
+// ByTokenFamilies orders the results by token_families terms.
+func ByTokenFamilies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTokenFamiliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAPIKeysCount orders the results by api_keys count.
+func ByAPIKeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAPIKeysStep(), opts...)
+	}
+}
+
+// ByAPIKeys orders the results by api_keys terms.
+func ByAPIKeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAPIKeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTotpCredentialField orders the results by totp_credential field.
+func ByTotpCredentialField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTotpCredentialStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRecoveryCodesCount orders the results by recovery_codes count.
+func ByRecoveryCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecoveryCodesStep(), opts...)
+	}
+}
+
+// ByRecoveryCodes orders the results by recovery_codes terms.
+func ByRecoveryCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecoveryCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMfaChallengesCount orders the results by mfa_challenges count.
+func ByMfaChallengesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMfaChallengesStep(), opts...)
+	}
+}
+
+// ByMfaChallenges orders the results by mfa_challenges terms.
+func ByMfaChallenges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMfaChallengesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByPasskeysCount orders the results by passkeys count.
+func ByPasskeysCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPasskeysStep(), opts...)
+	}
+}
+
+// ByPasskeys orders the results by passkeys terms.
+func ByPasskeys(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPasskeysStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebauthnChallengesCount orders the results by webauthn_challenges count.
+func ByWebauthnChallengesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebauthnChallengesStep(), opts...)
+	}
+}
+
+// ByWebauthnChallenges orders the results by webauthn_challenges terms.
+func ByWebauthnChallenges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebauthnChallengesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMagicLinksCount orders the results by magic_links count.
+func ByMagicLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMagicLinksStep(), opts...)
+	}
+}
+
+// ByMagicLinks orders the results by magic_links terms.
+func ByMagicLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMagicLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContactChangesCount orders the results by contact_changes count.
+func ByContactChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContactChangesStep(), opts...)
+	}
+}
+
+// ByContactChanges orders the results by contact_changes terms.
+func ByContactChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContactChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAccountLinksCount orders the results by account_links count.
+func ByAccountLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAccountLinksStep(), opts...)
+	}
+}
+
+// ByAccountLinks orders the results by account_links terms.
+func ByAccountLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuditEventsCount orders the results by audit_events count.
+func ByAuditEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditEventsStep(), opts...)
+	}
+}
+
+// ByAuditEvents orders the results by audit_events terms.
+func ByAuditEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthFlowsCount orders the results by oauth_flows count.
+func ByOauthFlowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthFlowsStep(), opts...)
+	}
+}
+
+// ByOauthFlows orders the results by oauth_flows terms.
+func ByOauthFlows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthFlowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAccountsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -221,3 +513,94 @@ func newOtpsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OtpsTable, OtpsColumn),
 	)
 }
+func newRefreshTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefreshTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+	)
+}
+func newTokenFamiliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TokenFamiliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TokenFamiliesTable, TokenFamiliesColumn),
+	)
+}
+func newAPIKeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(APIKeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, APIKeysTable, APIKeysColumn),
+	)
+}
+func newTotpCredentialStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TotpCredentialInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, TotpCredentialTable, TotpCredentialColumn),
+	)
+}
+func newRecoveryCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecoveryCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecoveryCodesTable, RecoveryCodesColumn),
+	)
+}
+func newMfaChallengesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MfaChallengesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MfaChallengesTable, MfaChallengesColumn),
+	)
+}
+func newPasskeysStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PasskeysInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PasskeysTable, PasskeysColumn),
+	)
+}
+func newWebauthnChallengesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebauthnChallengesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebauthnChallengesTable, WebauthnChallengesColumn),
+	)
+}
+func newMagicLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MagicLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MagicLinksTable, MagicLinksColumn),
+	)
+}
+func newContactChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContactChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContactChangesTable, ContactChangesColumn),
+	)
+}
+func newAccountLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountLinksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AccountLinksTable, AccountLinksColumn),
+	)
+}
+func newAuditEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditEventsTable, AuditEventsColumn),
+	)
+}
+func newOauthFlowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthFlowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthFlowsTable, OauthFlowsColumn),
+	)
+}
@@ -0,0 +1,202 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallenge is the model entity for the WebAuthnChallenge schema.
+type WebAuthnChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Challenge holds the value of the "challenge" field.
+	Challenge string `json:"challenge,omitempty"`
+	// Ceremony holds the value of the "ceremony" field.
+	Ceremony webauthnchallenge.Ceremony `json:"ceremony,omitempty"`
+	// SessionData holds the value of the "session_data" field.
+	SessionData []byte `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebAuthnChallengeQuery when eager-loading is set.
+	Edges                    WebAuthnChallengeEdges `json:"edges"`
+	user_webauthn_challenges *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// WebAuthnChallengeEdges holds the relations/edges for other nodes in the graph.
+type WebAuthnChallengeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebAuthnChallengeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebAuthnChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldSessionData:
+			values[i] = new([]byte)
+		case webauthnchallenge.FieldChallenge, webauthnchallenge.FieldCeremony:
+			values[i] = new(sql.NullString)
+		case webauthnchallenge.FieldCreatedAt, webauthnchallenge.FieldUpdatedAt, webauthnchallenge.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		case webauthnchallenge.FieldID:
+			values[i] = new(uuid.UUID)
+		case webauthnchallenge.ForeignKeys[0]: // user_webauthn_challenges
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebAuthnChallenge fields.
+func (_m *WebAuthnChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webauthnchallenge.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case webauthnchallenge.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case webauthnchallenge.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case webauthnchallenge.FieldChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field challenge", values[i])
+			} else if value.Valid {
+				_m.Challenge = value.String
+			}
+		case webauthnchallenge.FieldCeremony:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ceremony", values[i])
+			} else if value.Valid {
+				_m.Ceremony = webauthnchallenge.Ceremony(value.String)
+			}
+		case webauthnchallenge.FieldSessionData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field session_data", values[i])
+			} else if value != nil {
+				_m.SessionData = *value
+			}
+		case webauthnchallenge.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case webauthnchallenge.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_webauthn_challenges", values[i])
+			} else if value.Valid {
+				_m.user_webauthn_challenges = new(uuid.UUID)
+				*_m.user_webauthn_challenges = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebAuthnChallenge.
+// This includes values selected through modifiers, order, etc.
+func (_m *WebAuthnChallenge) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WebAuthnChallenge entity.
+func (_m *WebAuthnChallenge) QueryUser() *UserQuery {
+	return NewWebAuthnChallengeClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this WebAuthnChallenge.
+// Note that you need to call WebAuthnChallenge.Unwrap() before calling this method if this WebAuthnChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WebAuthnChallenge) Update() *WebAuthnChallengeUpdateOne {
+	return NewWebAuthnChallengeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WebAuthnChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WebAuthnChallenge) Unwrap() *WebAuthnChallenge {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebAuthnChallenge is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WebAuthnChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebAuthnChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("challenge=")
+	builder.WriteString(_m.Challenge)
+	builder.WriteString(", ")
+	builder.WriteString("ceremony=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Ceremony))
+	builder.WriteString(", ")
+	builder.WriteString("session_data=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebAuthnChallenges is a parsable slice of WebAuthnChallenge.
+type WebAuthnChallenges []*WebAuthnChallenge
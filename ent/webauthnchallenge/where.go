@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package webauthnchallenge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Challenge applies equality check predicate on the "challenge" field. It's identical to ChallengeEQ.
+func Challenge(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldChallenge, v))
+}
+
+// SessionData applies equality check predicate on the "session_data" field. It's identical to SessionDataEQ.
+func SessionData(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldSessionData, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ChallengeEQ applies the EQ predicate on the "challenge" field.
+func ChallengeEQ(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldChallenge, v))
+}
+
+// ChallengeNEQ applies the NEQ predicate on the "challenge" field.
+func ChallengeNEQ(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldChallenge, v))
+}
+
+// ChallengeIn applies the In predicate on the "challenge" field.
+func ChallengeIn(vs ...string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldChallenge, vs...))
+}
+
+// ChallengeNotIn applies the NotIn predicate on the "challenge" field.
+func ChallengeNotIn(vs ...string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldChallenge, vs...))
+}
+
+// ChallengeGT applies the GT predicate on the "challenge" field.
+func ChallengeGT(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldChallenge, v))
+}
+
+// ChallengeGTE applies the GTE predicate on the "challenge" field.
+func ChallengeGTE(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldChallenge, v))
+}
+
+// ChallengeLT applies the LT predicate on the "challenge" field.
+func ChallengeLT(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldChallenge, v))
+}
+
+// ChallengeLTE applies the LTE predicate on the "challenge" field.
+func ChallengeLTE(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldChallenge, v))
+}
+
+// ChallengeContains applies the Contains predicate on the "challenge" field.
+func ChallengeContains(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldContains(FieldChallenge, v))
+}
+
+// ChallengeHasPrefix applies the HasPrefix predicate on the "challenge" field.
+func ChallengeHasPrefix(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldHasPrefix(FieldChallenge, v))
+}
+
+// ChallengeHasSuffix applies the HasSuffix predicate on the "challenge" field.
+func ChallengeHasSuffix(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldHasSuffix(FieldChallenge, v))
+}
+
+// ChallengeEqualFold applies the EqualFold predicate on the "challenge" field.
+func ChallengeEqualFold(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEqualFold(FieldChallenge, v))
+}
+
+// ChallengeContainsFold applies the ContainsFold predicate on the "challenge" field.
+func ChallengeContainsFold(v string) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldContainsFold(FieldChallenge, v))
+}
+
+// CeremonyEQ applies the EQ predicate on the "ceremony" field.
+func CeremonyEQ(v Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldCeremony, v))
+}
+
+// CeremonyNEQ applies the NEQ predicate on the "ceremony" field.
+func CeremonyNEQ(v Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldCeremony, v))
+}
+
+// CeremonyIn applies the In predicate on the "ceremony" field.
+func CeremonyIn(vs ...Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldCeremony, vs...))
+}
+
+// CeremonyNotIn applies the NotIn predicate on the "ceremony" field.
+func CeremonyNotIn(vs ...Ceremony) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldCeremony, vs...))
+}
+
+// SessionDataEQ applies the EQ predicate on the "session_data" field.
+func SessionDataEQ(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldSessionData, v))
+}
+
+// SessionDataNEQ applies the NEQ predicate on the "session_data" field.
+func SessionDataNEQ(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldSessionData, v))
+}
+
+// SessionDataIn applies the In predicate on the "session_data" field.
+func SessionDataIn(vs ...[]byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldSessionData, vs...))
+}
+
+// SessionDataNotIn applies the NotIn predicate on the "session_data" field.
+func SessionDataNotIn(vs ...[]byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldSessionData, vs...))
+}
+
+// SessionDataGT applies the GT predicate on the "session_data" field.
+func SessionDataGT(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldSessionData, v))
+}
+
+// SessionDataGTE applies the GTE predicate on the "session_data" field.
+func SessionDataGTE(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldSessionData, v))
+}
+
+// SessionDataLT applies the LT predicate on the "session_data" field.
+func SessionDataLT(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldSessionData, v))
+}
+
+// SessionDataLTE applies the LTE predicate on the "session_data" field.
+func SessionDataLTE(v []byte) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldSessionData, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WebAuthnChallenge) predicate.WebAuthnChallenge {
+	return predicate.WebAuthnChallenge(sql.NotPredicates(p))
+}
@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
+	"github.com/google/uuid"
+)
+
+// WebAuthnChallengeCreate is the builder for creating a WebAuthnChallenge entity.
+type WebAuthnChallengeCreate struct {
+	config
+	mutation *WebAuthnChallengeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebAuthnChallengeCreate) SetCreatedAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableCreatedAt(v *time.Time) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WebAuthnChallengeCreate) SetUpdatedAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableUpdatedAt(v *time.Time) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetChallenge sets the "challenge" field.
+func (_c *WebAuthnChallengeCreate) SetChallenge(v string) *WebAuthnChallengeCreate {
+	_c.mutation.SetChallenge(v)
+	return _c
+}
+
+// SetCeremony sets the "ceremony" field.
+func (_c *WebAuthnChallengeCreate) SetCeremony(v webauthnchallenge.Ceremony) *WebAuthnChallengeCreate {
+	_c.mutation.SetCeremony(v)
+	return _c
+}
+
+// SetSessionData sets the "session_data" field.
+func (_c *WebAuthnChallengeCreate) SetSessionData(v []byte) *WebAuthnChallengeCreate {
+	_c.mutation.SetSessionData(v)
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *WebAuthnChallengeCreate) SetExpiresAt(v time.Time) *WebAuthnChallengeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableExpiresAt(v *time.Time) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WebAuthnChallengeCreate) SetID(v uuid.UUID) *WebAuthnChallengeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableID(v *uuid.UUID) *WebAuthnChallengeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *WebAuthnChallengeCreate) SetUserID(id uuid.UUID) *WebAuthnChallengeCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_c *WebAuthnChallengeCreate) SetNillableUserID(id *uuid.UUID) *WebAuthnChallengeCreate {
+	if id != nil {
+		_c = _c.SetUserID(*id)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WebAuthnChallengeCreate) SetUser(v *User) *WebAuthnChallengeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the WebAuthnChallengeMutation object of the builder.
+func (_c *WebAuthnChallengeCreate) Mutation() *WebAuthnChallengeMutation {
+	return _c.mutation
+}
+
+// Save creates the WebAuthnChallenge in the database.
+func (_c *WebAuthnChallengeCreate) Save(ctx context.Context) (*WebAuthnChallenge, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebAuthnChallengeCreate) SaveX(ctx context.Context) *WebAuthnChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebAuthnChallengeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebAuthnChallengeCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webauthnchallenge.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := webauthnchallenge.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		v := webauthnchallenge.DefaultExpiresAt()
+		_c.mutation.SetExpiresAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := webauthnchallenge.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebAuthnChallengeCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.updated_at"`)}
+	}
+	if _, ok := _c.mutation.Challenge(); !ok {
+		return &ValidationError{Name: "challenge", err: errors.New(`ent: missing required field "WebAuthnChallenge.challenge"`)}
+	}
+	if v, ok := _c.mutation.Challenge(); ok {
+		if err := webauthnchallenge.ChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "challenge", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.challenge": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Ceremony(); !ok {
+		return &ValidationError{Name: "ceremony", err: errors.New(`ent: missing required field "WebAuthnChallenge.ceremony"`)}
+	}
+	if v, ok := _c.mutation.Ceremony(); ok {
+		if err := webauthnchallenge.CeremonyValidator(v); err != nil {
+			return &ValidationError{Name: "ceremony", err: fmt.Errorf(`ent: validator failed for field "WebAuthnChallenge.ceremony": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SessionData(); !ok {
+		return &ValidationError{Name: "session_data", err: errors.New(`ent: missing required field "WebAuthnChallenge.session_data"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "WebAuthnChallenge.expires_at"`)}
+	}
+	return nil
+}
+
+func (_c *WebAuthnChallengeCreate) sqlSave(ctx context.Context) (*WebAuthnChallenge, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebAuthnChallengeCreate) createSpec() (*WebAuthnChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebAuthnChallenge{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webauthnchallenge.Table, sqlgraph.NewFieldSpec(webauthnchallenge.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.Challenge(); ok {
+		_spec.SetField(webauthnchallenge.FieldChallenge, field.TypeString, value)
+		_node.Challenge = value
+	}
+	if value, ok := _c.mutation.Ceremony(); ok {
+		_spec.SetField(webauthnchallenge.FieldCeremony, field.TypeEnum, value)
+		_node.Ceremony = value
+	}
+	if value, ok := _c.mutation.SessionData(); ok {
+		_spec.SetField(webauthnchallenge.FieldSessionData, field.TypeBytes, value)
+		_node.SessionData = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(webauthnchallenge.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webauthnchallenge.UserTable,
+			Columns: []string{webauthnchallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_webauthn_challenges = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebAuthnChallengeCreateBulk is the builder for creating many WebAuthnChallenge entities in bulk.
+type WebAuthnChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*WebAuthnChallengeCreate
+}
+
+// Save creates the WebAuthnChallenge entities in the database.
+func (_c *WebAuthnChallengeCreateBulk) Save(ctx context.Context) ([]*WebAuthnChallenge, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebAuthnChallenge, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebAuthnChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreateBulk) SaveX(ctx context.Context) []*WebAuthnChallenge {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebAuthnChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebAuthnChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -2,30 +2,73 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"log"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/NikSchaefer/go-fiber/ent"
-	_ "github.com/lib/pq"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/session"
+	"github.com/lib/pq"
 )
 
 var DB *ent.Client
 
+// legacyColumns were removed from the schema but would still break inserts,
+// like the NOT NULL plaintext otps.code. Schema.Create never drops columns, so
+// these are dropped explicitly and nothing else is lost to a schema change or
+// a rollback to an older release.
+var legacyColumns = []struct {
+	table  string
+	column string
+}{
+	{"otps", "code"},
+}
+
 func InitializeDB(autoMigrate bool) {
-	client, err := ent.Open("postgres", config.GetDatabaseURL())
+	drv, err := entsql.Open(dialect.Postgres, config.GetDatabaseURL())
 	if err != nil {
 		log.Fatal(err)
 	}
 
+	client := ent.NewClient(ent.Driver(drv))
 	DB = client
 
 	// TODO: Should move this to a lambda function that can be triggered
 	// with the github action only run migrations if explicitly requested
 	if autoMigrate {
+		if err := dropLegacyColumns(context.Background(), drv.DB()); err != nil {
+			log.Fatalf("failed dropping legacy columns: %v", err)
+		}
+
 		if err := client.Schema.Create(context.Background()); err != nil {
 			log.Fatalf("failed creating schema resources: %v", err)
 		}
+
+		// Sessions issued before tokens were hashed have no token_hash and can
+		// never be looked up again, so drop them instead of leaving them around
+		if _, err := client.Session.Delete().Where(session.TokenHashIsNil()).Exec(context.Background()); err != nil {
+			log.Printf("failed purging legacy sessions: %v", err)
+		}
+
+		// Likewise for one-time codes that were stored in plaintext
+		if _, err := client.OTP.Delete().Where(otp.CodeHashIsNil()).Exec(context.Background()); err != nil {
+			log.Printf("failed purging legacy one-time codes: %v", err)
+		}
+	}
+}
+
+// dropLegacyColumns removes the columns in legacyColumns, doing nothing once they are gone
+func dropLegacyColumns(ctx context.Context, db *sql.DB) error {
+	for _, c := range legacyColumns {
+		_, err := db.ExecContext(ctx, "ALTER TABLE IF EXISTS "+pq.QuoteIdentifier(c.table)+" DROP COLUMN IF EXISTS "+pq.QuoteIdentifier(c.column))
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 func CloseDB() {
@@ -0,0 +1,293 @@
+package auth_handlers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/enttest"
+	"github.com/NikSchaefer/go-fiber/ent/loginthrottle"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	auth_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/auth"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/totp"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	testPassword   = "correct horse battery staple"
+	testLinkToken  = "pending-link-token"
+	testProviderID = "google-sub-123"
+)
+
+// setupAccountLinkApp creates a user with a password and a pending Google link
+// for them, and mounts the link confirmation and MFA login routes
+func setupAccountLinkApp(t *testing.T) (*fiber.App, *ent.User) {
+	t.Helper()
+
+	validator.InitializeValidator()
+	notifications.InitService()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	database.DB = client
+
+	u := client.User.Create().
+		SetEmail("jane@example.com").
+		SetEmailVerified(true).
+		SaveX(t.Context())
+	client.Profile.Create().
+		SetName("Jane Doe").
+		SetUser(u).
+		SaveX(t.Context())
+
+	hash, err := utils.HashAndSalt([]byte(testPassword))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.Account.Create().
+		SetUser(u).
+		SetType(account.TypePassword).
+		SetPasswordHash(hash).
+		SaveX(t.Context())
+
+	createPendingLink(t, u, testLinkToken)
+
+	app := fiber.New()
+	app.Post("/auth/oauth/link/confirm", auth_handlers.ConfirmAccountLink)
+	app.Post("/auth/login/mfa", auth_handlers.VerifyLoginWithMFA)
+
+	return app, u
+}
+
+// createPendingLink parks a Google login for u, replacing the previous one the
+// way a new provider login does
+func createPendingLink(t *testing.T, u *ent.User, token string) {
+	t.Helper()
+
+	database.DB.AccountLink.Delete().ExecX(t.Context())
+	database.DB.AccountLink.Create().
+		SetUser(u).
+		SetTokenHash(utils.HashToken(token)).
+		SetType(accountlink.TypeGoogle).
+		SetProviderID(testProviderID).
+		SaveX(t.Context())
+}
+
+// enableTOTP gives the user a confirmed authenticator and returns its secret
+func enableTOTP(t *testing.T, u *ent.User) string {
+	t.Helper()
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	database.DB.TOTPCredential.Create().
+		SetUser(u).
+		SetSecret(secret).
+		SetConfirmedAt(time.Now()).
+		SaveX(t.Context())
+	return secret
+}
+
+func googleLinked(t *testing.T) bool {
+	t.Helper()
+
+	return database.DB.Account.Query().
+		Where(
+			account.TypeEQ(account.TypeGoogle),
+			account.ProviderID(testProviderID),
+		).
+		ExistX(t.Context())
+}
+
+func TestConfirmAccountLinkWithoutMFA(t *testing.T) {
+	app, _ := setupAccountLinkApp(t)
+
+	status, body := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": testLinkToken,
+		"password":  testPassword,
+	})
+	if status != fiber.StatusOK || body["status"] != "authenticated" {
+		t.Fatalf("confirm = %d %v", status, body)
+	}
+	if !googleLinked(t) {
+		t.Error("provider not linked")
+	}
+	if database.DB.AccountLink.Query().ExistX(t.Context()) {
+		t.Error("pending link not consumed")
+	}
+}
+
+func TestConfirmAccountLinkRequiresMFA(t *testing.T) {
+	app, u := setupAccountLinkApp(t)
+	secret := enableTOTP(t, u)
+
+	status, body := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": testLinkToken,
+		"password":  testPassword,
+	})
+	if status != fiber.StatusOK || body["mfaRequired"] != true {
+		t.Fatalf("confirm = %d %v, want an MFA challenge", status, body)
+	}
+	if googleLinked(t) {
+		t.Fatal("provider linked before the second factor")
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("sessions = %d before the second factor", n)
+	}
+
+	code, err := totp.CodeAt(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, body = call(t, app, "/auth/login/mfa", map[string]string{
+		"mfaToken": body["mfaToken"].(string),
+		"code":     code,
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("mfa = %d %v", status, body)
+	}
+	if !googleLinked(t) {
+		t.Error("provider not linked after the second factor")
+	}
+	if database.DB.AccountLink.Query().ExistX(t.Context()) {
+		t.Error("pending link not consumed")
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("sessions = %d, want 1", n)
+	}
+}
+
+func TestConfirmAccountLinkWrongSecondFactorDoesNotLink(t *testing.T) {
+	app, u := setupAccountLinkApp(t)
+	secret := enableTOTP(t, u)
+
+	_, body := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": testLinkToken,
+		"password":  testPassword,
+	})
+	mfaToken, _ := body["mfaToken"].(string)
+	if mfaToken == "" {
+		t.Fatalf("confirm = %v, want an MFA challenge", body)
+	}
+
+	// A code from an hour ago is well outside the accepted skew
+	code, err := totp.CodeAt(secret, totp.Step(time.Now().Add(-time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ := call(t, app, "/auth/login/mfa", map[string]string{
+		"mfaToken": mfaToken,
+		"code":     code,
+	})
+	if status != fiber.StatusUnauthorized {
+		t.Fatalf("mfa = %d, want 401", status)
+	}
+	if googleLinked(t) {
+		t.Error("provider linked with a wrong second factor")
+	}
+}
+
+func TestConfirmAccountLinkReplacesEarlierChallenge(t *testing.T) {
+	app, u := setupAccountLinkApp(t)
+	enableTOTP(t, u)
+
+	for i := 0; i < 2; i++ {
+		status, body := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+			"linkToken": testLinkToken,
+			"password":  testPassword,
+		})
+		if status != fiber.StatusOK || body["mfaRequired"] != true {
+			t.Fatalf("confirm %d = %d %v", i, status, body)
+		}
+	}
+
+	if n := database.DB.MFAChallenge.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("challenges = %d, want 1", n)
+	}
+}
+
+func TestConfirmAccountLinkPasswordIsThrottled(t *testing.T) {
+	t.Setenv("LOGIN_ACCOUNT_BACKOFF_AFTER", "3")
+	t.Setenv("LOGIN_ACCOUNT_LOCKOUT_AFTER", "3")
+	app, u := setupAccountLinkApp(t)
+
+	// Each guess on a fresh link, as an attacker logging in with the provider again would
+	for i := 0; i < 3; i++ {
+		token := fmt.Sprintf("link-%d", i)
+		createPendingLink(t, u, token)
+		status, _ := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+			"linkToken": token,
+			"password":  "wrong password",
+		})
+		if status != fiber.StatusUnauthorized {
+			t.Fatalf("guess %d = %d, want 401", i, status)
+		}
+	}
+
+	createPendingLink(t, u, "link-final")
+	status, _ := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": "link-final",
+		"password":  testPassword,
+	})
+	if status != fiber.StatusTooManyRequests {
+		t.Fatalf("correct password while locked out = %d, want 429", status)
+	}
+	if googleLinked(t) {
+		t.Error("provider linked while the account is locked out")
+	}
+}
+
+func TestConfirmAccountLinkClearsFailuresOnlyAfterMFA(t *testing.T) {
+	app, u := setupAccountLinkApp(t)
+	secret := enableTOTP(t, u)
+
+	status, _ := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": testLinkToken,
+		"password":  "wrong password",
+	})
+	if status != fiber.StatusUnauthorized {
+		t.Fatalf("wrong password = %d, want 401", status)
+	}
+
+	_, body := call(t, app, "/auth/oauth/link/confirm", map[string]string{
+		"linkToken": testLinkToken,
+		"password":  testPassword,
+	})
+	mfaToken, _ := body["mfaToken"].(string)
+	if mfaToken == "" {
+		t.Fatalf("confirm = %v, want an MFA challenge", body)
+	}
+	if !accountThrottled(t) {
+		t.Fatal("password failures cleared before the second factor")
+	}
+
+	code, err := totp.CodeAt(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, body = call(t, app, "/auth/login/mfa", map[string]string{
+		"mfaToken": mfaToken,
+		"code":     code,
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("mfa = %d %v", status, body)
+	}
+	if accountThrottled(t) {
+		t.Error("password failures not cleared after the login completed")
+	}
+}
+
+func accountThrottled(t *testing.T) bool {
+	t.Helper()
+
+	return database.DB.LoginThrottle.Query().
+		Where(loginthrottle.KeyHasPrefix("account:")).
+		ExistX(t.Context())
+}
@@ -0,0 +1,212 @@
+package auth_handlers
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// appleStateCookie holds the web flow's state. Apple posts the callback from its
+// own origin, so the cookie has to be SameSite=None to be sent along.
+const appleStateCookie = "oauth_state_apple"
+
+// GetAppleAuthRedirect starts the web flow, returning the URL of Apple's consent page
+func GetAppleAuthRedirect(c *fiber.Ctx) error {
+	data := new(OAuthRedirectRequest)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(data); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+		}
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if !services.AppleConfigured() {
+		return fiber.NewError(fiber.StatusNotFound, "Sign in with Apple is not configured")
+	}
+
+	// Apple doesn't support PKCE, the identity token's nonce binds it to the flow instead
+	flow, state, err := services.StartOAuthFlow(c.Context(), "apple", services.OAuthFlowOptions{
+		Purpose:  oauthflow.PurposeLogin,
+		ReturnTo: data.ReturnTo,
+	})
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	url, err := services.GetAppleAuthURL(flow, state)
+	if err != nil {
+		return err
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     appleStateCookie,
+		Value:    state,
+		Expires:  flow.ExpiresAt,
+		HTTPOnly: true,
+		Secure:   true,
+		SameSite: "None",
+	})
+
+	return c.JSON(url)
+}
+
+type AppleAuthCallbackRequest struct {
+	Code  string `json:"code" form:"code" validate:"required"`
+	State string `json:"state" form:"state" validate:"required"`
+	// User is only sent on the first authorization, as JSON with the user's name
+	User string `json:"user" form:"user"`
+}
+
+// GetAppleAuthCallback handles the web flow. Apple posts it as a form straight
+// to APPLE_REDIRECT_URL, in which case the browser is redirected back to the
+// frontend once logged in. Frontends using Apple's JS popup post it as JSON.
+func GetAppleAuthCallback(c *fiber.Ctx) error {
+	data := new(AppleAuthCallbackRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	// Check the state before redeeming the code, so a forged callback can't use it
+	savedState := c.Cookies(appleStateCookie)
+	if savedState == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "state not found")
+	}
+	if savedState != data.State {
+		return fiber.NewError(fiber.StatusBadRequest, "state does not match")
+	}
+	c.ClearCookie(appleStateCookie)
+
+	flow, err := services.ConsumeOAuthFlow(c.Context(), "apple", data.State, oauthflow.PurposeLogin, nil)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	identity, err := services.ExchangeAppleCode(c.Context(), flow, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	formPost := strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEApplicationForm)
+
+	return loginWithApple(c, identity, services.ParseAppleUser(data.User).FullName(), formPost, flow.ReturnTo)
+}
+
+type AppleNativeAuthCallbackRequest struct {
+	IdentityToken string `json:"identityToken" validate:"required"`
+	// Nonce is the raw nonce whose SHA-256 the app passed to the authorization request
+	Nonce string `json:"nonce"`
+	// The name is only available to the app on the first authorization
+	FirstName string `json:"firstName" validate:"max=100"`
+	LastName  string `json:"lastName" validate:"max=100"`
+}
+
+// GetAppleNativeAuthCallback logs in with an identity token obtained by an iOS or macOS app
+func GetAppleNativeAuthCallback(c *fiber.Ctx) error {
+	data := new(AppleNativeAuthCallbackRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	bundleIDs := config.GetAppleBundleIDs()
+	if len(bundleIDs) == 0 {
+		return fiber.NewError(fiber.StatusNotFound, "Sign in with Apple is not configured")
+	}
+
+	identity, err := services.VerifyAppleIDToken(c.Context(), data.IdentityToken, bundleIDs, data.Nonce)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	name := (&services.AppleName{FirstName: data.FirstName, LastName: data.LastName}).FullName()
+
+	return loginWithApple(c, identity, name, false, "")
+}
+
+func loginWithApple(c *fiber.Ctx, identity *services.AppleIdentity, name string, formPost bool, returnTo string) error {
+	// The email scope is optional, and there is no account to find or create without one
+	if identity.Email == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "The provider did not share an email address")
+	}
+
+	// Apple only shares the name once. If that response was lost, fall back to
+	// something presentable the user can change in their profile.
+	if len(name) < 2 && !identity.PrivateEmail {
+		name = strings.Split(identity.Email, "@")[0]
+	}
+	if len(name) < 2 {
+		name = "Apple User"
+	}
+
+	// Apple only signs addresses it has verified, see services.VerifyAppleIDToken
+	u, err := services.HandleOauthLogin(services.HandleOauthLoginStruct{
+		Email:         identity.Email,
+		EmailVerified: true,
+		Name:          name,
+		Type:          "apple",
+		ProviderID:    identity.Subject,
+		Meta:          services.GetSessionMetadata(c),
+	})
+	if err != nil {
+		// Browsers coming back from Apple's form post land on the frontend,
+		// which picks up the link token to confirm the link from there
+		if link, ok := err.(*services.AccountLinkRequiredError); ok {
+			if formPost {
+				return c.Redirect(config.GetApplePostLoginRedirect()+"?link_token="+url.QueryEscape(link.Token), fiber.StatusSeeOther)
+			}
+			return err
+		}
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	}
+
+	if !formPost {
+		return completeOAuthLogin(c, u, returnTo)
+	}
+
+	// create session
+	s, sessionToken, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	services.SetSessionCookie(c, sessionToken, s)
+
+	// returnTo was checked against ALLOWED_ORIGINS when the flow started
+	if returnTo != "" {
+		return c.Redirect(returnTo, fiber.StatusSeeOther)
+	}
+	return c.Redirect(config.GetApplePostLoginRedirect(), fiber.StatusSeeOther)
+}
@@ -0,0 +1,26 @@
+package auth_handlers
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestLoginWithAppleRequiresEmail(t *testing.T) {
+	app := fiber.New()
+	app.Post("/", func(c *fiber.Ctx) error {
+		return loginWithApple(c, &services.AppleIdentity{Subject: "001234.abcd"}, "Jane Doe", false, "")
+	})
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != fiber.StatusBadRequest || string(body) != "The provider did not share an email address" {
+		t.Errorf("login without email = %d %q, want 400", resp.StatusCode, body)
+	}
+}
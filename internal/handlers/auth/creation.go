@@ -3,8 +3,6 @@ package auth_handlers
 import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/NikSchaefer/go-fiber/internal/services"
-	"github.com/NikSchaefer/go-fiber/pkg/notifications"
-	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
 )
 
@@ -12,7 +10,7 @@ func SignUp(c *fiber.Ctx) error {
 	type SignUpRequest struct {
 		Name     string `json:"name" validate:"required,min=2,max=100"`
 		Email    string `json:"email" validate:"required,email"`
-		Password string `json:"password" validate:"required,min=8"`
+		Password string `json:"password" validate:"required"`
 	}
 
 	data := new(SignUpRequest)
@@ -34,19 +32,8 @@ func SignUp(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, ""+err.Error())
 	}
 
-	otp, err := services.GenerateOTP(u)
-	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
-	}
-
-	err = notifications.Send(notifications.NotificationRequest{
-		TemplateID: "otp",
-		Data: &templates.OTPTemplateData{
-			OTP:  otp.Code,
-			Name: data.Name,
-		},
-		EmailAddress: &u.Email,
-	})
+	// The account stays limited by EMAIL_VERIFICATION_POLICY until the code is entered
+	_, err = services.SendEmailVerification(c.Context(), u)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
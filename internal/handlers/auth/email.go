@@ -0,0 +1,121 @@
+package auth_handlers
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+func VerifyEmail(c *fiber.Ctx) error {
+	type VerifyEmailRequest struct {
+		Email string `json:"email" validate:"required,email"`
+		Code  string `json:"code" validate:"required"`
+	}
+	data := new(VerifyEmailRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, err := database.DB.User.Query().
+		Where(user.EmailEQ(strings.ToLower(data.Email))).
+		Only(c.Context())
+	if ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusNotFound, "User not found")
+	}
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	u, err = services.VerifyEmail(c.Context(), u, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	analytics.TrackEventWithUser("email_verified", map[string]interface{}{
+		"user": u.ID,
+	}, u)
+
+	return c.JSON(u)
+}
+
+func ResendEmailVerification(c *fiber.Ctx) error {
+	type ResendEmailVerificationRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+	data := new(ResendEmailVerificationRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, err := database.DB.User.Query().
+		Where(user.EmailEQ(strings.ToLower(data.Email))).
+		Only(c.Context())
+	if ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusNotFound, "User not found")
+	}
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	wait, err := services.SendEmailVerification(c.Context(), u)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	if wait > 0 {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		return fiber.NewError(fiber.StatusTooManyRequests, "A verification email was sent recently, try again later")
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func RevertEmailChange(c *fiber.Ctx) error {
+	type RevertEmailChangeRequest struct {
+		Token string `json:"token" validate:"required"`
+	}
+	data := new(RevertEmailChangeRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	err = services.RevertEmailChange(c.Context(), data.Token)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Email change reverted",
+	})
+}
@@ -0,0 +1,174 @@
+package auth_handlers
+
+import (
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+func RequestMagicLink(c *fiber.Ctx) error {
+	type RequestMagicLinkRequest struct {
+		Email      string `json:"email" validate:"required,email"`
+		RedirectTo string `json:"redirectTo" validate:"omitempty,url"`
+		// SameDevice only logs in the requesting device, which polls for the session
+		SameDevice bool `json:"sameDevice"`
+	}
+	data := new(RequestMagicLinkRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, err := database.DB.User.Query().
+		Where(user.EmailEQ(strings.ToLower(data.Email))).
+		Only(c.Context())
+	if err != nil && !ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	opts := services.MagicLinkOptions{
+		RedirectTo: data.RedirectTo,
+		SameDevice: data.SameDevice,
+	}
+
+	// Unknown emails get the same answer without an email, so the endpoint
+	// can't be used to find out who has an account
+	if u == nil {
+		pollToken, expiresAt, err := services.DecoyMagicLink(opts)
+		if err != nil {
+			if _, ok := err.(*fiber.Error); ok {
+				return err
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+
+		return c.JSON(fiber.Map{
+			"pollToken": pollToken,
+			"expiresAt": expiresAt,
+		})
+	}
+
+	pollToken, link, err := services.SendMagicLink(c.Context(), u, opts, services.GetSessionMetadata(c))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"pollToken": pollToken,
+		"expiresAt": link.ExpiresAt,
+	})
+}
+
+func VerifyMagicLink(c *fiber.Ctx) error {
+	type VerifyMagicLinkRequest struct {
+		Token string `json:"token" validate:"required"`
+		// PollToken is sent when the link is opened on the device that requested it
+		PollToken string `json:"pollToken"`
+	}
+	data := new(VerifyMagicLinkRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, link, err := services.VerifyMagicLink(c.Context(), data.Token, data.PollToken)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	if u == nil {
+		// Same-device link opened elsewhere, the requesting device picks up the session
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"status": "approved",
+		})
+	}
+
+	return completeMagicLinkLogin(c, u, link)
+}
+
+func PollMagicLink(c *fiber.Ctx) error {
+	type PollMagicLinkRequest struct {
+		PollToken string `json:"pollToken" validate:"required"`
+	}
+	data := new(PollMagicLinkRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, link, err := services.PollMagicLink(c.Context(), data.PollToken)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	if u == nil {
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"status": "pending",
+		})
+	}
+
+	return completeMagicLinkLogin(c, u, link)
+}
+
+// completeMagicLinkLogin logs in the device that redeemed the link, handing the
+// frontend the redirect target chosen when the link was requested
+func completeMagicLinkLogin(c *fiber.Ctx, u *ent.User, link *ent.MagicLink) error {
+	mfaRequired, err := services.HasMFAEnabled(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	if mfaRequired {
+		return respondWithMFAChallenge(c, u, mfachallenge.MethodMagicLink)
+	}
+
+	analytics.TrackEventWithUser("magic_link_login", map[string]interface{}{
+		"user":        u.ID,
+		"same_device": link.SameDevice,
+	}, u)
+
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
+
+	// create session
+	s, token, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	services.SetSessionCookie(c, token, s)
+
+	return c.JSON(fiber.Map{
+		"status":     "authenticated",
+		"user":       u,
+		"redirectTo": link.RedirectTo,
+	})
+}
@@ -0,0 +1,102 @@
+package auth_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// respondWithMFAChallenge ends the first login step for users with two-factor enabled.
+// The client exchanges the token and a code at /auth/login/mfa for a session or tokens.
+func respondWithMFAChallenge(c *fiber.Ctx, u *ent.User, method mfachallenge.Method) error {
+	token, err := services.CreateMFAChallenge(c.Context(), u, method)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return mfaChallengeResponse(c, token)
+}
+
+// respondWithAccountLinkMFAChallenge is respondWithMFAChallenge for a confirmed
+// account link, the provider is only linked once the challenge is completed
+func respondWithAccountLinkMFAChallenge(c *fiber.Ctx, u *ent.User, link *ent.AccountLink, method mfachallenge.Method) error {
+	token, err := services.CreateAccountLinkMFAChallenge(c.Context(), u, link, method)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return mfaChallengeResponse(c, token)
+}
+
+func mfaChallengeResponse(c *fiber.Ctx, token string) error {
+	return c.JSON(fiber.Map{
+		"mfaRequired": true,
+		"mfaToken":    token,
+		"methods":     []string{"totp", "recovery_code"},
+	})
+}
+
+func VerifyLoginWithMFA(c *fiber.Ctx) error {
+	type VerifyMFARequest struct {
+		MFAToken     string `json:"mfaToken" validate:"required"`
+		Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,numeric,len=6"`
+		RecoveryCode string `json:"recoveryCode" validate:"required_without=Code"`
+	}
+	data := new(VerifyMFARequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, challenge, err := services.CompleteMFAChallenge(c.Context(), data.MFAToken, data.Code, data.RecoveryCode)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	if link := challenge.Edges.AccountLink; link != nil {
+		err = services.CompleteAccountLink(c.Context(), u, link, services.GetSessionMetadata(c))
+		if err != nil {
+			if _, ok := err.(*fiber.Error); ok {
+				return err
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+	}
+
+	if challenge.Method == mfachallenge.MethodPassword {
+		err = services.ResetUserLoginFailures(c.Context(), u)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+	}
+
+	analytics.TrackEventWithUser(string(challenge.Method)+"_login", map[string]interface{}{
+		"user":          u.ID,
+		"mfa":           true,
+		"recovery_code": data.Code == "",
+	}, u)
+
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
+
+	// create session
+	s, token, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	services.SetSessionCookie(c, token, s)
+
+	return c.JSON(u)
+}
@@ -1,50 +1,89 @@
 package auth_handlers
 
 import (
-	"encoding/json"
-	"time"
-
 	"github.com/NikSchaefer/go-fiber/config"
-	"github.com/NikSchaefer/go-fiber/ent/schema"
-	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
 	"github.com/NikSchaefer/go-fiber/internal/services"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
 	"github.com/gofiber/fiber/v2"
-	"golang.org/x/oauth2"
 )
 
-// GetGoogleAuthRedirect initiates the OAuth flow by redirecting the user to Google's consent page
-func GetGoogleAuthRedirect(c *fiber.Ctx) error {
-	// Get the OAuth configuration for Google
-	conf := config.ConfigGoogle()
+// GetOAuthProviders lists the providers that can be logged in with
+func GetOAuthProviders(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"providers": services.EnabledOAuthProviders(),
+	})
+}
+
+// oauthStateCookie binds a flow to the browser that started it, so a callback
+// carrying someone else's state can't log the browser in to their account
+func oauthStateCookie(provider string) string {
+	return "oauth_state_" + provider
+}
+
+type OAuthRedirectRequest struct {
+	// ReturnTo is handed back by the callback, see services.ValidateRedirectURL
+	ReturnTo string `json:"returnTo" validate:"omitempty,url,max=2048"`
+}
+
+// GetOAuthRedirect initiates the OAuth flow, returning the provider's consent page URL
+func GetOAuthRedirect(c *fiber.Ctx) error {
+	data := new(OAuthRedirectRequest)
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(data); err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
+		}
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
 
-	// Generate a random state string to prevent CSRF attacks
-	state := oauth2.GenerateVerifier()
+	provider, err := services.GetOAuthProvider(c.Context(), c.Params("provider"))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
 
-	// Generate the authorization URL with the state parameter
-	// AccessTypeOffline allows getting a refresh token
-	url := conf.AuthCodeURL(state, oauth2.AccessTypeOffline)
+	// The state, PKCE verifier and nonce stay on the server, the browser only
+	// gets the state to send back
+	flow, state, err := services.StartOAuthFlow(c.Context(), provider.Name, services.OAuthFlowOptions{
+		Purpose:  oauthflow.PurposeLogin,
+		ReturnTo: data.ReturnTo,
+		PKCE:     true,
+	})
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
 
-	// Store the state in a cookie for verification when Google calls back
 	c.Cookie(&fiber.Cookie{
-		Name:     "oauth_state_google",
+		Name:     oauthStateCookie(provider.Name),
 		Value:    state,
-		Expires:  time.Now().Add(1 * time.Hour),
-		HTTPOnly: true, // Prevents JavaScript access to the cookie
+		Expires:  flow.ExpiresAt,
+		HTTPOnly: true,
+		Secure:   config.GetIsProduction(),
+		SameSite: "Lax",
 	})
 
-	// Send user to Google's consent page
-	return c.JSON(url)
+	return c.JSON(provider.AuthCodeURL(flow, state))
 }
 
-type GoogleAuthCallbackRequest struct {
+type OAuthCallbackRequest struct {
 	Code  string `json:"code" validate:"required"`
 	State string `json:"state" validate:"required"`
 }
 
-// GetGoogleAuthCallback handles the response from Google after user consents
-func GetGoogleAuthCallback(c *fiber.Ctx) error {
-	data := new(GoogleAuthCallbackRequest)
+// GetOAuthCallback handles the response from the provider after user consents
+func GetOAuthCallback(c *fiber.Ctx) error {
+	data := new(OAuthCallbackRequest)
 	if err := c.BodyParser(data); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
@@ -54,86 +93,129 @@ func GetGoogleAuthCallback(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	// Get the OAuth configuration
-	conf := config.ConfigGoogle()
-
-	// Exchange the authorization code for an access token
-	token, err := conf.Exchange(c.Context(), data.Code)
+	provider, err := services.GetOAuthProvider(c.Context(), c.Params("provider"))
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	// Get the state from the cookie
-	savedState := c.Cookies("oauth_state_google")
+	// Check the state before redeeming the code, so a forged callback can't use it
+	savedState := c.Cookies(oauthStateCookie(provider.Name))
 	if savedState == "" {
 		return fiber.NewError(fiber.StatusBadRequest, "state not found")
 	}
 	if savedState != data.State {
 		return fiber.NewError(fiber.StatusBadRequest, "state does not match")
 	}
+	c.ClearCookie(oauthStateCookie(provider.Name))
 
-	// Use the access token to fetch the user's information from Google
-	resp, err := conf.Client(c.Context(), token).Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	flow, err := services.ConsumeOAuthFlow(c.Context(), provider.Name, data.State, oauthflow.PurposeLogin, nil)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
-	defer resp.Body.Close()
 
-	// Define a struct to parse the user information from Google
-	var userInfo struct {
-		ID            string `json:"id"`
-		Email         string `json:"email"`
-		VerifiedEmail bool   `json:"verified_email"`
-		Name          string `json:"name"`
-		Picture       string `json:"picture"`
+	// Exchange the authorization code for an access token
+	token, err := provider.Exchange(c.Context(), flow, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	// Parse the JSON response from Google into the userInfo struct
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Failed to parse user info")
+	profile, err := provider.Profile(c.Context(), token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to fetch user info: "+err.Error())
 	}
 
-	// Check if the email is verified
-	if !userInfo.VerifiedEmail {
-		return fiber.NewError(fiber.StatusBadRequest, "Google email not verified")
+	return loginWithOAuthProfile(c, profile, flow.ReturnTo)
+}
+
+type GoogleNativeAuthCallbackRequest struct {
+	IDToken string `json:"idToken" validate:"required"`
+}
+
+// GetGoogleNativeAuthCallback logs in with an ID token obtained by the Google
+// Sign-In SDK of a web, iOS or Android client
+func GetGoogleNativeAuthCallback(c *fiber.Ctx) error {
+	data := new(GoogleNativeAuthCallbackRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	u, err := services.HandleOauthLogin(services.HandleOauthLoginStruct{
-		Email:      userInfo.Email,
-		Name:       userInfo.Name,
-		Type:       "google",
-		ProviderID: userInfo.ID,
-		AvatarURL:  &userInfo.Picture,
-	})
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	profile, err := services.VerifyGoogleIDToken(c.Context(), data.IDToken)
 	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return loginWithOAuthProfile(c, profile, "")
+}
+
+// loginWithOAuthProfile finds or creates the user for a normalized provider profile and logs them in
+func loginWithOAuthProfile(c *fiber.Ctx, profile *services.HandleOauthLoginStruct, returnTo string) error {
+	if profile.Email == "" {
+		return fiber.NewError(fiber.StatusBadRequest, "The provider did not share an email address")
+	}
+
+	profile.Meta = services.GetSessionMetadata(c)
+
+	u, err := services.HandleOauthLogin(*profile)
+	if err != nil {
+		// Rendered by middleware.ErrorHandler
+		if _, ok := err.(*services.AccountLinkRequiredError); ok {
+			return err
+		}
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
 		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
 	}
 
-	db := database.DB
+	return completeOAuthLogin(c, u, returnTo)
+}
+
+// completeOAuthLogin issues tokens or a session for a user who logged in with
+// a provider, handing back where the flow asked to return to
+func completeOAuthLogin(c *fiber.Ctx, u *ent.User, returnTo string) error {
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
+
 	// create session
-	s, err := db.Session.Create().
-		SetUser(u).
-		Save(c.Context())
+	s, sessionToken, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	c.Cookie(&fiber.Cookie{
-		Name:     "session",
-		Value:    s.ID.String(),
-		Expires:  schema.GetTokenExpiration(),
-		HTTPOnly: true,
-	})
+	services.SetSessionCookie(c, sessionToken, s)
 
-	return c.JSON(u)
+	return c.JSON(fiber.Map{
+		"status":   "authenticated",
+		"user":     u,
+		"returnTo": returnTo,
+	})
 }
 
-type GoogleNativeAuthCallbackRequest struct {
-	AccessToken string `json:"accessToken" validate:"required"`
+type AccountLinkCodeRequest struct {
+	LinkToken string `json:"linkToken" validate:"required"`
 }
 
-func GetGoogleNativeAuthCallback(c *fiber.Ctx) error {
-	data := new(GoogleNativeAuthCallbackRequest)
+// RequestAccountLinkCode emails the existing user a code to confirm linking a provider
+func RequestAccountLinkCode(c *fiber.Ctx) error {
+	data := new(AccountLinkCodeRequest)
 	if err := c.BodyParser(data); err != nil {
 		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
@@ -143,69 +225,79 @@ func GetGoogleNativeAuthCallback(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	conf := config.ConfigGoogle()
-
-	// Create a token struct with the provided access token
-	token := &oauth2.Token{
-		AccessToken: data.AccessToken,
-	}
-
-	// Use the token to fetch the user's information from Google
-	resp, err := conf.Client(c.Context(), token).Get("https://www.googleapis.com/oauth2/v2/userinfo")
+	err = services.SendAccountLinkCode(c.Context(), data.LinkToken)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
-	defer resp.Body.Close()
 
-	// Define a struct to parse the user information from Google
-	var userInfo struct {
-		ID            string `json:"id"`
-		Email         string `json:"email"`
-		VerifiedEmail bool   `json:"verified_email"`
-		Name          string `json:"name"`
-		Picture       string `json:"picture"`
-	}
+	return c.JSON(fiber.Map{
+		"message": "Code sent",
+	})
+}
+
+type ConfirmAccountLinkRequest struct {
+	LinkToken string `json:"linkToken" validate:"required"`
+	Password  string `json:"password" validate:"required_without=Code"`
+	Code      string `json:"code" validate:"required_without=Password"`
+}
 
-	// Parse the JSON response from Google into the userInfo struct
-	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Failed to parse user info")
+// ConfirmAccountLink links the provider to the existing user once they proved
+// the account is theirs, then logs them in. Users with two-factor enabled get an
+// MFA challenge instead and the provider is linked in VerifyLoginWithMFA.
+func ConfirmAccountLink(c *fiber.Ctx) error {
+	data := new(ConfirmAccountLinkRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid request body")
 	}
 
-	if userInfo.Email == "" {
-		return fiber.NewError(fiber.StatusBadRequest, "Google email not returned: "+userInfo.Email)
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	// // Check if the email is verified
-	// if !userInfo.VerifiedEmail {
-	// 	return fiber.NewError(fiber.StatusBadRequest, "Google email not verified")
-	// }
+	link, err := services.VerifyAccountLink(c.Context(), data.LinkToken, services.AccountLinkCredentials{
+		Password: data.Password,
+		Code:     data.Code,
+	}, services.GetSessionMetadata(c))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	u := link.Edges.User
 
-	u, err := services.HandleOauthLogin(services.HandleOauthLoginStruct{
-		Email:      userInfo.Email,
-		Name:       userInfo.Name,
-		Type:       "google",
-		ProviderID: userInfo.ID,
-		AvatarURL:  &userInfo.Picture,
-	})
+	mfaRequired, err := services.HasMFAEnabled(c.Context(), u)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	// Linking before the second factor would let the provider log in without it.
+	// Failures are only cleared once the whole login succeeded, see VerifyLoginWithMFA
+	if mfaRequired {
+		method := mfachallenge.MethodPassword
+		if data.Code != "" {
+			method = mfachallenge.MethodOtp
+		}
+		return respondWithAccountLinkMFAChallenge(c, u, link, method)
 	}
 
-	db := database.DB
-	// create session
-	s, err := db.Session.Create().
-		SetUser(u).
-		Save(c.Context())
+	err = services.CompleteAccountLink(c.Context(), u, link, services.GetSessionMetadata(c))
 	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	c.Cookie(&fiber.Cookie{
-		Name:     "session",
-		Value:    s.ID.String(),
-		Expires:  schema.GetTokenExpiration(),
-		HTTPOnly: true,
-	})
+	if data.Password != "" {
+		err = services.ResetUserLoginFailures(c.Context(), u)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+	}
 
-	return c.JSON(u)
+	return completeOAuthLogin(c, u, "")
 }
@@ -3,11 +3,10 @@ package auth_handlers
 import (
 	"encoding/json"
 	"strings"
-	"time"
 
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
 	"github.com/NikSchaefer/go-fiber/ent/otp"
 	"github.com/NikSchaefer/go-fiber/ent/predicate"
-	"github.com/NikSchaefer/go-fiber/ent/schema"
 	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
 	"github.com/NikSchaefer/go-fiber/internal/services"
@@ -50,7 +49,8 @@ func RequestLoginWithOTP(c *fiber.Ctx) error {
 		conditions = append(conditions, user.EmailEQ(data.Email))
 	}
 	if data.Phone != "" {
-		conditions = append(conditions, user.PhoneNumberEQ(data.Phone))
+		// Numbers that were never confirmed by SMS can't be used to log in
+		conditions = append(conditions, user.And(user.PhoneNumberEQ(data.Phone), user.PhoneNumberVerified(true)))
 	}
 
 	u, err := db.User.Query().
@@ -61,7 +61,7 @@ func RequestLoginWithOTP(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusNotFound, err.Error())
 	}
 
-	otp, err := services.GenerateOTP(u)
+	code, err := services.GenerateOTP(c.Context(), u, otp.TypeLogin)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
@@ -78,7 +78,7 @@ func RequestLoginWithOTP(c *fiber.Ctx) error {
 	err = notifications.Send(notifications.NotificationRequest{
 		TemplateID: "otp",
 		Data: &templates.OTPTemplateData{
-			OTP:  otp.Code,
+			OTP:  code,
 			Name: u.Edges.Profile.Name,
 		},
 		EmailAddress: emailToSend,
@@ -124,7 +124,8 @@ func VerifyLoginWithOTP(c *fiber.Ctx) error {
 		conditions = append(conditions, user.EmailEQ(data.Email))
 	}
 	if data.Phone != "" {
-		conditions = append(conditions, user.PhoneNumberEQ(data.Phone))
+		// Numbers that were never confirmed by SMS can't be used to log in
+		conditions = append(conditions, user.And(user.PhoneNumberEQ(data.Phone), user.PhoneNumberVerified(true)))
 	}
 
 	u, err := db.User.Query().Where(user.Or(conditions...)).Only(c.Context())
@@ -132,20 +133,12 @@ func VerifyLoginWithOTP(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusNotFound, err.Error())
 	}
 
-	o, err := db.OTP.Query().Where(
-		otp.And(
-			otp.CodeEQ(data.Code),
-			otp.HasUserWith(user.IDEQ(u.ID)),
-			otp.ExpiresAtGTE(time.Now()),
-			otp.TypeEQ(otp.TypeLogin),
-		),
-	).Only(c.Context())
+	err = services.VerifyOTPCode(c.Context(), u, data.Code, otp.TypeLogin)
 	if err != nil {
-		return fiber.NewError(fiber.StatusNotFound, "Code not found")
-	}
-
-	if o.Used {
-		return fiber.NewError(fiber.StatusUnauthorized, "Code already used")
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
 	update := u.Update()
@@ -154,38 +147,34 @@ func VerifyLoginWithOTP(c *fiber.Ctx) error {
 		update.SetEmailVerified(true)
 	}
 
-	if data.Phone != "" {
-		update.SetPhoneNumberVerified(true)
-	}
-
 	_, err = update.Save(c.Context())
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	_, err = o.Update().SetUsed(true).Save(c.Context())
+	mfaRequired, err := services.HasMFAEnabled(c.Context(), u)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
+	if mfaRequired {
+		return respondWithMFAChallenge(c, u, mfachallenge.MethodOtp)
+	}
+
+	analytics.TrackEventWithUser("otp_login", map[string]interface{}{
+		"user": u.ID,
+	}, u)
+
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
 
 	// create session
-	s, err := db.Session.Create().
-		SetUser(u).
-		Save(c.Context())
+	s, token, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	c.Cookie(&fiber.Cookie{
-		Name:     "session",
-		Value:    s.ID.String(),
-		Expires:  schema.GetTokenExpiration(),
-		HTTPOnly: true,
-	})
-
-	analytics.TrackEventWithUser("otp_login", map[string]interface{}{
-		"user": u.ID,
-	}, u)
+	services.SetSessionCookie(c, token, s)
 
 	return c.JSON(u)
 }
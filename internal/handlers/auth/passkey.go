@@ -0,0 +1,74 @@
+package auth_handlers
+
+import (
+	"encoding/json"
+
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+func BeginLoginWithPasskey(c *fiber.Ctx) error {
+	assertion, err := services.BeginPasskeyLogin(c.Context())
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(assertion)
+}
+
+func FinishLoginWithPasskey(c *fiber.Ctx) error {
+	type FinishPasskeyLoginRequest struct {
+		// Credential is the PublicKeyCredential returned by navigator.credentials.get
+		Credential json.RawMessage `json:"credential" validate:"required"`
+	}
+	data := new(FinishPasskeyLoginRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u, userVerified, err := services.FinishPasskeyLogin(c.Context(), data.Credential)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	// A passkey that verified the user is two factors in itself, one that didn't
+	// is only something the user has
+	if !userVerified {
+		mfaRequired, err := services.HasMFAEnabled(c.Context(), u)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+		if mfaRequired {
+			return respondWithMFAChallenge(c, u, mfachallenge.MethodPasskey)
+		}
+	}
+
+	analytics.TrackEventWithUser("passkey_login", map[string]interface{}{
+		"user": u.ID,
+	}, u)
+
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
+
+	// create session
+	s, token, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	services.SetSessionCookie(c, token, s)
+
+	return c.JSON(u)
+}
@@ -0,0 +1,486 @@
+package auth_handlers_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/enttest"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	auth_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/auth"
+	user_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/users"
+	"github.com/NikSchaefer/go-fiber/pkg/totp"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/gofiber/fiber/v2"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:3000"
+)
+
+var b64 = base64.RawURLEncoding
+
+// softAuthenticator is a software passkey holding a single P-256 credential,
+// answering ceremonies the way a browser would hand them to the server
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	userHandle   []byte
+	signCount    uint32
+	// noUserVerification answers like a security key without a PIN or biometric
+	noUserVerification bool
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credentialID := make([]byte, 16)
+	if _, err := rand.Read(credentialID); err != nil {
+		t.Fatal(err)
+	}
+	return &softAuthenticator{key: key, credentialID: credentialID}
+}
+
+// authenticatorData builds the flags and counter part every response carries,
+// user presence always set
+func (a *softAuthenticator) authenticatorData(rpID string, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+
+	flags := byte(0x01)
+	if !a.noUserVerification {
+		flags |= 0x04
+	}
+	if attested != nil {
+		flags |= 0x40
+	}
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	return append(data, attested...)
+}
+
+func (a *softAuthenticator) clientData(t *testing.T, ceremony string, challenge string, origin string) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]string{
+		"type":      ceremony,
+		"challenge": challenge,
+		"origin":    origin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// create answers navigator.credentials.create with a "none" attestation
+func (a *softAuthenticator) create(t *testing.T, options map[string]any, origin string) json.RawMessage {
+	t.Helper()
+
+	publicKey := options["publicKey"].(map[string]any)
+	rp := publicKey["rp"].(map[string]any)
+	userHandle, err := b64.DecodeString(publicKey["user"].(map[string]any)["id"].(string))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.userHandle = userHandle
+
+	coseKey, err := cbor.Marshal(map[int]any{
+		1:  2,  // kty: EC2
+		3:  -7, // alg: ES256
+		-1: 1,  // crv: P-256
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attested := make([]byte, 16) // zero AAGUID
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, coseKey...)
+
+	attestationObject, err := cbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authenticatorData(rp["id"].(string), attested),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mustJSON(t, map[string]any{
+		"id":    b64.EncodeToString(a.credentialID),
+		"rawId": b64.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    b64.EncodeToString(a.clientData(t, "webauthn.create", publicKey["challenge"].(string), origin)),
+			"attestationObject": b64.EncodeToString(attestationObject),
+		},
+	})
+}
+
+// get answers navigator.credentials.get for a discoverable login
+func (a *softAuthenticator) get(t *testing.T, options map[string]any) json.RawMessage {
+	t.Helper()
+
+	publicKey := options["publicKey"].(map[string]any)
+
+	a.signCount++
+	authData := a.authenticatorData(publicKey["rpId"].(string), nil)
+	clientData := a.clientData(t, "webauthn.get", publicKey["challenge"].(string), testOrigin)
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mustJSON(t, map[string]any{
+		"id":    b64.EncodeToString(a.credentialID),
+		"rawId": b64.EncodeToString(a.credentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    b64.EncodeToString(clientData),
+			"authenticatorData": b64.EncodeToString(authData),
+			"signature":         b64.EncodeToString(signature),
+			"userHandle":        b64.EncodeToString(a.userHandle),
+		},
+	})
+}
+
+func mustJSON(t *testing.T, v any) []byte {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// setupPasskeyApp points the database at a fresh in-memory SQLite and mounts
+// the passkey routes, with registration acting as the given user
+func setupPasskeyApp(t *testing.T) (*fiber.App, *ent.User) {
+	t.Helper()
+
+	t.Setenv("WEBAUTHN_RP_ID", testRPID)
+	t.Setenv("WEBAUTHN_ORIGINS", testOrigin)
+	validator.InitializeValidator()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	database.DB = client
+
+	u := client.User.Create().
+		SetEmail("jane@example.com").
+		SetEmailVerified(true).
+		SaveX(t.Context())
+	client.Profile.Create().
+		SetName("Jane Doe").
+		SetUser(u).
+		SaveX(t.Context())
+
+	app := fiber.New()
+	app.Post("/auth/login/passkey/begin", auth_handlers.BeginLoginWithPasskey)
+	app.Post("/auth/login/passkey/finish", auth_handlers.FinishLoginWithPasskey)
+	app.Post("/auth/login/mfa", auth_handlers.VerifyLoginWithMFA)
+
+	asUser := func(c *fiber.Ctx) error {
+		c.Locals("user", u)
+		return c.Next()
+	}
+	app.Post("/users/passkeys/register/begin", asUser, user_handlers.BeginPasskeyRegistration)
+	app.Post("/users/passkeys/register/finish", asUser, user_handlers.FinishPasskeyRegistration)
+
+	return app, u
+}
+
+func call(t *testing.T, app *fiber.App, path string, body any) (int, map[string]any) {
+	t.Helper()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(mustJSON(t, body))
+	}
+	req := httptest.NewRequest(http.MethodPost, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := app.Test(req, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded map[string]any
+	_ = json.Unmarshal(raw, &decoded)
+	return res.StatusCode, decoded
+}
+
+// registerPasskey runs the registration ceremony and returns the finish status
+func registerPasskey(t *testing.T, app *fiber.App, a *softAuthenticator, origin string) (int, map[string]any) {
+	t.Helper()
+
+	status, options := call(t, app, "/users/passkeys/register/begin", nil)
+	if status != fiber.StatusOK {
+		t.Fatalf("register begin: status %d", status)
+	}
+
+	return call(t, app, "/users/passkeys/register/finish", map[string]any{
+		"name":       "Laptop",
+		"credential": a.create(t, options, origin),
+	})
+}
+
+func TestPasskeyRegistrationAndLogin(t *testing.T) {
+	app, u := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+
+	status, pk := registerPasskey(t, app, a, testOrigin)
+	if status != fiber.StatusCreated {
+		t.Fatalf("register finish: status %d, body %v", status, pk)
+	}
+	if pk["name"] != "Laptop" {
+		t.Errorf("passkey name = %v, want Laptop", pk["name"])
+	}
+	if !bytes.Equal(a.userHandle, u.ID[:]) {
+		t.Errorf("user handle = %x, want the user ID %x", a.userHandle, u.ID[:])
+	}
+
+	status, options := call(t, app, "/auth/login/passkey/begin", nil)
+	if status != fiber.StatusOK {
+		t.Fatalf("login begin: status %d", status)
+	}
+
+	status, body := call(t, app, "/auth/login/passkey/finish", map[string]any{
+		"credential": a.get(t, options),
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("login finish: status %d, body %v", status, body)
+	}
+	if body["id"] != u.ID.String() {
+		t.Errorf("logged in as %v, want %s", body["id"], u.ID)
+	}
+
+	stored := database.DB.Passkey.Query().OnlyX(t.Context())
+	if stored.SignCount != a.signCount {
+		t.Errorf("sign count = %d, want %d", stored.SignCount, a.signCount)
+	}
+	if stored.LastUsedAt == nil {
+		t.Error("last used time was not recorded")
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("sessions = %d, want 1", n)
+	}
+}
+
+func TestPasskeyRegistrationRejectsForeignOrigin(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+
+	status, _ := registerPasskey(t, app, newSoftAuthenticator(t), "https://evil.example.com")
+	if status != fiber.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusUnauthorized)
+	}
+	if n := database.DB.Passkey.Query().CountX(t.Context()); n != 0 {
+		t.Errorf("passkeys = %d, want 0", n)
+	}
+}
+
+func TestPasskeyRegistrationRejectsDuplicate(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("first registration: status %d", status)
+	}
+	// The begin options exclude the credential, an authenticator ignoring that is refused
+	if status, _ := registerPasskey(t, app, a, testOrigin); status == fiber.StatusCreated {
+		t.Fatal("the same credential was registered twice")
+	}
+}
+
+func TestPasskeyLoginChallengeIsSingleUse(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	credential := a.get(t, options)
+
+	if status, _ := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": credential}); status != fiber.StatusOK {
+		t.Fatalf("first login: status %d", status)
+	}
+	if status, _ := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": credential}); status != fiber.StatusUnauthorized {
+		t.Fatalf("replayed login: status %d, want %d", status, fiber.StatusUnauthorized)
+	}
+}
+
+func TestPasskeyLoginRejectsWrongKey(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	// Same credential ID and user handle, but signed by a different key
+	impostor := newSoftAuthenticator(t)
+	impostor.credentialID = a.credentialID
+	impostor.userHandle = a.userHandle
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	status, _ := call(t, app, "/auth/login/passkey/finish", map[string]any{
+		"credential": impostor.get(t, options),
+	})
+	if status != fiber.StatusUnauthorized {
+		t.Fatalf("status = %d, want %d", status, fiber.StatusUnauthorized)
+	}
+}
+
+func TestPasskeyLoginRejectsClonedAuthenticator(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	if status, _ := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": a.get(t, options)}); status != fiber.StatusOK {
+		t.Fatalf("first login: status %d", status)
+	}
+
+	// A clone replays the counter the original already used
+	a.signCount--
+	_, options = call(t, app, "/auth/login/passkey/begin", nil)
+	if status, _ := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": a.get(t, options)}); status != fiber.StatusUnauthorized {
+		t.Fatalf("cloned login: status %d, want %d", status, fiber.StatusUnauthorized)
+	}
+}
+
+func TestPasskeyLoginWithoutUserVerificationRequiresMFA(t *testing.T) {
+	app, u := setupPasskeyApp(t)
+	secret := enableTOTP(t, u)
+	a := newSoftAuthenticator(t)
+	a.noUserVerification = true
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	status, body := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": a.get(t, options)})
+	if status != fiber.StatusOK || body["mfaRequired"] != true {
+		t.Fatalf("login = %d %v, want an MFA challenge", status, body)
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 0 {
+		t.Fatalf("sessions = %d before the second factor", n)
+	}
+
+	code, err := totp.CodeAt(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, body = call(t, app, "/auth/login/mfa", map[string]string{
+		"mfaToken": body["mfaToken"].(string),
+		"code":     code,
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("mfa = %d %v", status, body)
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("sessions = %d, want 1", n)
+	}
+}
+
+func TestPasskeyLoginWithUserVerificationSkipsMFA(t *testing.T) {
+	app, u := setupPasskeyApp(t)
+	enableTOTP(t, u)
+	a := newSoftAuthenticator(t)
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	status, body := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": a.get(t, options)})
+	if status != fiber.StatusOK || body["mfaRequired"] != nil {
+		t.Fatalf("login = %d %v, want a session", status, body)
+	}
+	if n := database.DB.Session.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("sessions = %d, want 1", n)
+	}
+}
+
+func TestPasskeyLoginWithoutUserVerificationNoMFA(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+	a := newSoftAuthenticator(t)
+	a.noUserVerification = true
+
+	if status, _ := registerPasskey(t, app, a, testOrigin); status != fiber.StatusCreated {
+		t.Fatalf("register: status %d", status)
+	}
+
+	_, options := call(t, app, "/auth/login/passkey/begin", nil)
+	if status, body := call(t, app, "/auth/login/passkey/finish", map[string]any{"credential": a.get(t, options)}); status != fiber.StatusOK || body["mfaRequired"] != nil {
+		t.Fatalf("login = %d %v, want a session", status, body)
+	}
+}
+
+func TestPasskeyLoginPurgesExpiredChallenges(t *testing.T) {
+	app, _ := setupPasskeyApp(t)
+
+	for i := 0; i < 3; i++ {
+		if status, _ := call(t, app, "/auth/login/passkey/begin", nil); status != fiber.StatusOK {
+			t.Fatalf("begin: status %d", status)
+		}
+	}
+
+	// Expire the abandoned ones, the next ceremony clears them out
+	database.DB.WebAuthnChallenge.Delete().ExecX(t.Context())
+	for i := 0; i < 3; i++ {
+		database.DB.WebAuthnChallenge.Create().
+			SetChallenge(fmt.Sprintf("abandoned-%d", i)).
+			SetCeremony(webauthnchallenge.CeremonyLogin).
+			SetSessionData([]byte("{}")).
+			SetExpiresAt(time.Now().Add(-time.Minute)).
+			SaveX(t.Context())
+	}
+
+	if status, _ := call(t, app, "/auth/login/passkey/begin", nil); status != fiber.StatusOK {
+		t.Fatalf("begin: status %d", status)
+	}
+	if n := database.DB.WebAuthnChallenge.Query().CountX(t.Context()); n != 1 {
+		t.Errorf("challenges = %d, want only the new one", n)
+	}
+}
@@ -1,14 +1,13 @@
 package auth_handlers
 
 import (
-	"time"
-
 	"github.com/gofiber/fiber/v2"
 	"github.com/NikSchaefer/go-fiber/ent"
 	"github.com/NikSchaefer/go-fiber/ent/account"
 	"github.com/NikSchaefer/go-fiber/ent/otp"
 	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/services"
 	"github.com/NikSchaefer/go-fiber/pkg/notifications"
 	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
 	"github.com/NikSchaefer/go-fiber/pkg/utils"
@@ -17,8 +16,8 @@ import (
 
 func ChangePassword(c *fiber.Ctx) error {
 	type ChangePasswordRequest struct {
-		Password    string `json:"password" validate:"required,min=8"`
-		NewPassword string `json:"newPassword" validate:"required,min=8"`
+		Password    string `json:"password" validate:"required"`
+		NewPassword string `json:"newPassword" validate:"required"`
 	}
 
 	data := new(ChangePasswordRequest)
@@ -46,9 +45,9 @@ func ChangePassword(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusUnauthorized, "Current password is incorrect")
 	}
 
-	newHash, err := utils.HashAndSalt([]byte(data.NewPassword))
+	newHash, err := hashNewPassword(c, u, data.NewPassword)
 	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		return err
 	}
 
 	_, err = acc.Update().
@@ -89,10 +88,7 @@ func ResetPassword(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	o, err := db.OTP.Create().
-		SetType(otp.TypePasswordReset).
-		SetUser(u).
-		Save(c.Context())
+	code, err := services.GenerateOTP(c.Context(), u, otp.TypePasswordReset)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
@@ -100,7 +96,7 @@ func ResetPassword(c *fiber.Ctx) error {
 	err = notifications.Send(notifications.NotificationRequest{
 		TemplateID: "reset_password",
 		Data: &templates.ResetPasswordTemplateData{
-			ResetCode: o.Code,
+			ResetCode: code,
 			Name:      u.Edges.Profile.Name,
 			Email:     u.Email,
 		},
@@ -119,7 +115,7 @@ func VerifyResetPassword(c *fiber.Ctx) error {
 	type VerifyResetPasswordRequest struct {
 		Email       string `json:"email" validate:"required,email"`
 		Code        string `json:"code" validate:"required"`
-		NewPassword string `json:"newPassword" validate:"required,min=8"`
+		NewPassword string `json:"newPassword" validate:"required"`
 	}
 	data := new(VerifyResetPasswordRequest)
 	if err := c.BodyParser(data); err != nil {
@@ -140,20 +136,27 @@ func VerifyResetPassword(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "User not found")
 	}
 
-	o, err := db.OTP.Query().
-		Where(otp.And(
-			otp.CodeEQ(data.Code),
-			otp.TypeEQ(otp.TypePasswordReset),
-			otp.ExpiresAtGTE(time.Now()),
-			otp.HasUserWith(user.IDEQ(u.ID)),
-		)).
-		Only(c.Context())
+	// Check the code before the new password, so the policy never runs for a caller
+	// without one, but only redeem it once the password is accepted
+	code, err := services.CheckOTPCode(c.Context(), u, data.Code, otp.TypePasswordReset)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, "Invalid code")
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	if o.Used {
-		return fiber.NewError(fiber.StatusBadRequest, "Code already used")
+	newHash, err := hashNewPassword(c, u, data.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	err = services.ConsumeOTP(c.Context(), code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
 	acc, err := db.Account.Query().
@@ -165,10 +168,6 @@ func VerifyResetPassword(c *fiber.Ctx) error {
 			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 		}
 		// Create new password account if it doesn't exist
-		newHash, err := utils.HashAndSalt([]byte(data.NewPassword))
-		if err != nil {
-			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
-		}
 		_, err = db.Account.Create().
 			SetType(account.TypePassword).
 			SetUser(u).
@@ -179,22 +178,30 @@ func VerifyResetPassword(c *fiber.Ctx) error {
 		}
 	} else {
 		// Update existing account
-		newHash, err := utils.HashAndSalt([]byte(data.NewPassword))
-		if err != nil {
-			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
-		}
 		_, err = acc.Update().SetPasswordHash(newHash).Save(c.Context())
 		if err != nil {
 			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 		}
 	}
 
-	_, err = o.Update().SetUsed(true).Save(c.Context())
-	if err != nil {
-		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
-	}
-
 	return c.JSON(fiber.Map{
 		"message": "Reset code verified successfully",
 	})
 }
+
+// hashNewPassword checks the password against the policy and hashes it
+func hashNewPassword(c *fiber.Ctx, u *ent.User, password string) ([]byte, error) {
+	inputs, err := services.PasswordUserInputs(c.Context(), u)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	hash, err := services.HashNewPassword(c.Context(), password, inputs...)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return nil, err
+		}
+		return nil, fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	return hash, nil
+}
@@ -0,0 +1,137 @@
+package auth_handlers_test
+
+import (
+	"testing"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/enttest"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	auth_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/auth"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/password"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// setupResetApp creates a user with a pending password reset code and mounts
+// the reset verification route
+func setupResetApp(t *testing.T) (*fiber.App, *ent.User, string) {
+	t.Helper()
+
+	validator.InitializeValidator()
+	password.InitPolicy()
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	database.DB = client
+
+	u := client.User.Create().
+		SetEmail("jane@example.com").
+		SetEmailVerified(true).
+		SaveX(t.Context())
+	client.Profile.Create().
+		SetName("Jane Doe").
+		SetUser(u).
+		SaveX(t.Context())
+
+	code, err := services.GenerateOTP(t.Context(), u, otp.TypePasswordReset)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Post("/auth/password/reset/verify", auth_handlers.VerifyResetPassword)
+
+	return app, u, code
+}
+
+func passwordMatches(t *testing.T, u *ent.User, password string) bool {
+	t.Helper()
+
+	acc, err := database.DB.Account.Query().
+		Where(account.TypeEQ(account.TypePassword)).
+		Only(t.Context())
+	if ent.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return utils.ComparePasswords(acc.PasswordHash, []byte(password))
+}
+
+func TestVerifyResetPassword(t *testing.T) {
+	app, u, code := setupResetApp(t)
+
+	status, body := call(t, app, "/auth/password/reset/verify", map[string]string{
+		"email":       u.Email,
+		"code":        code,
+		"newPassword": testPassword,
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("reset = %d %v", status, body)
+	}
+	if !passwordMatches(t, u, testPassword) {
+		t.Fatal("password not set")
+	}
+
+	status, _ = call(t, app, "/auth/password/reset/verify", map[string]string{
+		"email":       u.Email,
+		"code":        code,
+		"newPassword": "another " + testPassword,
+	})
+	if status != fiber.StatusUnauthorized {
+		t.Errorf("reusing the code = %d, want 401", status)
+	}
+}
+
+func TestVerifyResetPasswordRejectedPasswordKeepsCode(t *testing.T) {
+	app, u, code := setupResetApp(t)
+
+	for _, weak := range []string{"short", "jane@example.com"} {
+		status, _ := call(t, app, "/auth/password/reset/verify", map[string]string{
+			"email":       u.Email,
+			"code":        code,
+			"newPassword": weak,
+		})
+		if status != fiber.StatusBadRequest {
+			t.Fatalf("weak password %q = %d, want 400", weak, status)
+		}
+	}
+
+	// Each request still counts as an attempt, but the code stays live
+	if database.DB.OTP.Query().Where(otp.Used(false), otp.Attempts(2)).CountX(t.Context()) != 1 {
+		t.Fatal("rejected passwords used up the code")
+	}
+
+	status, body := call(t, app, "/auth/password/reset/verify", map[string]string{
+		"email":       u.Email,
+		"code":        code,
+		"newPassword": testPassword,
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("reset after a rejected password = %d %v", status, body)
+	}
+	if !passwordMatches(t, u, testPassword) {
+		t.Error("password not set")
+	}
+}
+
+func TestVerifyResetPasswordChecksCodeFirst(t *testing.T) {
+	app, u, _ := setupResetApp(t)
+
+	// Without the code, a password the policy would reject must not reveal why
+	for _, weak := range []string{"short", "Jane Doe 2024!", "jane@example.com"} {
+		status, body := call(t, app, "/auth/password/reset/verify", map[string]string{
+			"email":       u.Email,
+			"code":        "not-a-code",
+			"newPassword": weak,
+		})
+		if status != fiber.StatusUnauthorized {
+			t.Fatalf("wrong code with %q = %d %v, want 401", weak, status, body)
+		}
+	}
+}
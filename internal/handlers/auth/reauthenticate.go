@@ -0,0 +1,78 @@
+package auth_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequestReauthOTP emails the current user a code for POST /auth/reauthenticate
+func RequestReauthOTP(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	profile, err := u.QueryProfile().Only(c.Context())
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	code, err := services.GenerateOTP(c.Context(), u, otp.TypeReauthentication)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	err = notifications.Send(notifications.NotificationRequest{
+		TemplateID: "otp",
+		Data: &templates.OTPTemplateData{
+			OTP:  code,
+			Name: profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func Reauthenticate(c *fiber.Ctx) error {
+	type ReauthenticateRequest struct {
+		Password string `json:"password" validate:"omitempty"`
+		OTP      string `json:"otp" validate:"omitempty"`
+		TOTPCode string `json:"totpCode" validate:"omitempty,numeric,len=6"`
+	}
+	data := new(ReauthenticateRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+	s, _ := c.Locals("session").(*ent.Session)
+	claims, _ := c.Locals("token_claims").(*tokens.AccessClaims)
+
+	authenticatedAt, err := services.Reauthenticate(c.Context(), u, s, claims, services.ReauthCredentials{
+		Password: data.Password,
+		OTP:      data.OTP,
+		TOTPCode: data.TOTPCode,
+	}, services.GetSessionMetadata(c))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"authenticatedAt": authenticatedAt,
+	})
+}
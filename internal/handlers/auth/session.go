@@ -1,22 +1,43 @@
 package auth_handlers
 
 import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
 	"github.com/NikSchaefer/go-fiber/ent"
 	"github.com/NikSchaefer/go-fiber/ent/account"
-	"github.com/NikSchaefer/go-fiber/ent/schema"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
 	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/services"
 	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
 	"github.com/NikSchaefer/go-fiber/pkg/utils"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 )
 
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+)
+
+// dummyPasswordHash is compared against when there is no real hash, so failed
+// logins for unknown accounts cost as much as for known ones
+func dummyPasswordHash() []byte {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = utils.HashAndSalt([]byte("dummy password for timing"))
+	})
+	return dummyHash
+}
+
 func LoginWithPassword(c *fiber.Ctx) error {
 	type LoginRequest struct {
 		Email    string `json:"email" validate:"omitempty,email"`
-		Password string `json:"password" validate:"required,min=8"`
+		Password string `json:"password" validate:"required"`
 		Phone    string `json:"phone" validate:"omitempty,e164"`
 	}
 	data := new(LoginRequest)
@@ -33,71 +54,153 @@ func LoginWithPassword(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, "Email or Phone is required")
 	}
 
+	identifier := strings.ToLower(data.Email)
+	if identifier == "" {
+		identifier = data.Phone
+	}
+	ip := services.GetSessionMetadata(c).IPAddress
+
+	wait, err := services.CheckLoginAllowed(c.Context(), identifier, ip)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	if wait > 0 {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		return fiber.NewError(fiber.StatusTooManyRequests, "Too many failed login attempts, try again later")
+	}
+
+	lookup := user.PhoneNumberEQ(data.Phone)
+	if data.Email != "" {
+		lookup = user.EmailEQ(identifier)
+	}
+
 	db := database.DB
-	u, err := db.User.Query().Where(
-		user.Or(
-			user.EmailEQ(data.Email),
-			user.PhoneNumberEQ(data.Phone),
-		),
-	).
+	u, err := db.User.Query().Where(lookup).
 		WithAccounts(func(q *ent.AccountQuery) {
 			q.Where(account.TypeEQ(account.TypePassword))
 		}).
 		Only(c.Context())
-	if err != nil {
-		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	if err != nil && !ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
-	// Check if user has a password account
-	if len(u.Edges.Accounts) == 0 {
-		return fiber.NewError(fiber.StatusUnauthorized, "No password found for this user")
+	// Unknown accounts, accounts without a password and wrong passwords all look
+	// the same to the client, and take the same bcrypt time
+	var passwordHash []byte
+	if u != nil && len(u.Edges.Accounts) > 0 {
+		passwordHash = u.Edges.Accounts[0].PasswordHash
+	} else {
+		passwordHash = dummyPasswordHash()
 	}
 
-	if !utils.ComparePasswords(u.Edges.Accounts[0].PasswordHash, []byte(data.Password)) {
-		return fiber.NewError(fiber.StatusUnauthorized, "Password is incorrect")
+	if !utils.ComparePasswords(passwordHash, []byte(data.Password)) || u == nil || len(u.Edges.Accounts) == 0 {
+		err := services.RecordLoginFailure(c.Context(), identifier, ip, u)
+		if err != nil {
+			if _, ok := err.(*fiber.Error); ok {
+				return err
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid credentials")
 	}
 
-	// create session
-	s, err := db.Session.Create().
-		SetUser(u).
-		Save(c.Context())
+	mfaRequired, err := services.HasMFAEnabled(c.Context(), u)
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
+	// Failures are only cleared once the whole login succeeded, see VerifyLoginWithMFA
+	if mfaRequired {
+		return respondWithMFAChallenge(c, u, mfachallenge.MethodPassword)
+	}
 
-	c.Cookie(&fiber.Cookie{
-		Name:     "session",
-		Value:    s.ID.String(),
-		Expires:  schema.GetTokenExpiration(),
-		HTTPOnly: true,
-	})
+	err = services.ResetLoginFailures(c.Context(), identifier)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
 
 	analytics.TrackEventWithUser("password_login", map[string]interface{}{
 		"user": u.ID,
 	}, u)
 
+	if wantsTokens(c) {
+		return respondWithTokens(c, u)
+	}
+
+	// create session
+	s, token, err := services.CreateSession(c.Context(), u, services.GetSessionMetadata(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	services.SetSessionCookie(c, token, s)
+
 	return c.JSON(fiber.Map{
-		"session": s.ID,
+		"session": token,
 	})
 }
 
-func Logout(c *fiber.Ctx) error {
-	sessionID := c.Cookies("session")
-	if sessionID == "" {
-		return fiber.NewError(fiber.StatusUnauthorized, "No session provided")
+func UnlockLogin(c *fiber.Ctx) error {
+	type UnlockLoginRequest struct {
+		Token string `json:"token" validate:"required"`
+	}
+	data := new(UnlockLoginRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
 	}
 
-	id, err := uuid.Parse(sessionID)
+	err := validator.Validate(data)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, "Invalid session format")
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	err = services.UnlockLogin(c.Context(), data.Token)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Account unlocked",
+	})
+}
+
+func Logout(c *fiber.Ctx) error {
+	// Bearer clients log out by revoking the token family their access token belongs to
+	if claims, ok := c.Locals("token_claims").(*tokens.AccessClaims); ok {
+		id, err := uuid.Parse(claims.SessionID)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid token session")
+		}
+
+		err = services.RevokeUserTokenFamily(c.Context(), c.Locals("user").(*ent.User), id)
+		if err != nil {
+			if _, ok := err.(*fiber.Error); ok {
+				return err
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+
+		return c.JSON(fiber.Map{
+			"message": "success",
+		})
+	}
+
+	// The session was resolved from the hashed token by middleware.Authenticated
+	s, ok := c.Locals("session").(*ent.Session)
+	if !ok {
+		return fiber.NewError(fiber.StatusUnauthorized, "No session provided")
 	}
 	db := database.DB
 
-	err = db.Session.DeleteOneID(id).Exec(c.Context())
+	err := db.Session.DeleteOneID(s.ID).Exec(c.Context())
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
+	c.ClearCookie("session")
+
 	return c.JSON(fiber.Map{
 		"message": "success",
 	})
@@ -0,0 +1,79 @@
+package auth_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+// wantsTokens reports whether the client asked for bearer tokens instead of a session cookie
+func wantsTokens(c *fiber.Ctx) bool {
+	return c.Get("X-Auth-Mode") == "token"
+}
+
+// respondWithTokens finishes a login for bearer token clients
+func respondWithTokens(c *fiber.Ctx, u *ent.User) error {
+	pair, err := services.IssueTokenPair(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	return c.JSON(pair)
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken" validate:"required"`
+}
+
+func RefreshToken(c *fiber.Ctx) error {
+	data := new(RefreshTokenRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	pair, err := services.RotateRefreshToken(c.Context(), data.RefreshToken)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(pair)
+}
+
+func RevokeToken(c *fiber.Ctx) error {
+	data := new(RefreshTokenRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON sent")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	err = services.RevokeRefreshToken(c.Context(), data.RefreshToken)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "success",
+	})
+}
+
+// GetJWKS publishes the public keys access tokens can be verified with
+func GetJWKS(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.JSON(tokens.JWKS())
+}
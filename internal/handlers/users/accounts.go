@@ -0,0 +1,182 @@
+package users_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func GetAccounts(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	accounts, err := services.ListAccounts(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(accounts)
+}
+
+// BeginLinkAccount returns the provider's consent page URL for linking it to
+// the current user. The flow is bound to the user, so its callback can only
+// link to them and a login callback can't be replayed to link.
+func BeginLinkAccount(c *fiber.Ctx) error {
+	provider, err := services.GetOAuthProvider(c.Context(), c.Params("provider"))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	flow, state, err := services.StartOAuthFlow(c.Context(), provider.Name, services.OAuthFlowOptions{
+		Purpose: oauthflow.PurposeLink,
+		User:    u,
+		PKCE:    true,
+	})
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(provider.AuthCodeURL(flow, state))
+}
+
+func FinishLinkAccount(c *fiber.Ctx) error {
+	type Request struct {
+		Code  string `json:"code" validate:"required"`
+		State string `json:"state" validate:"required"`
+	}
+
+	data := new(Request)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	provider, err := services.GetOAuthProvider(c.Context(), c.Params("provider"))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	flow, err := services.ConsumeOAuthFlow(c.Context(), provider.Name, data.State, oauthflow.PurposeLink, u)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	token, err := provider.Exchange(c.Context(), flow, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	profile, err := provider.Profile(c.Context(), token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Failed to fetch user info: "+err.Error())
+	}
+
+	return linkAccount(c, profile)
+}
+
+// LinkNativeAccount links an Apple identity token or a Google ID token obtained by an app
+func LinkNativeAccount(c *fiber.Ctx) error {
+	type Request struct {
+		Token string `json:"token" validate:"required"`
+		// Nonce is the raw nonce passed to Apple's authorization request
+		Nonce string `json:"nonce"`
+	}
+
+	data := new(Request)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	var profile *services.HandleOauthLoginStruct
+	var err error
+	switch c.Params("provider") {
+	case "apple":
+		bundleIDs := config.GetAppleBundleIDs()
+		if len(bundleIDs) == 0 {
+			return fiber.NewError(fiber.StatusNotFound, "Sign in with Apple is not configured")
+		}
+		var identity *services.AppleIdentity
+		identity, err = services.VerifyAppleIDToken(c.Context(), data.Token, bundleIDs, data.Nonce)
+		if err == nil {
+			profile = &services.HandleOauthLoginStruct{
+				Email:         identity.Email,
+				EmailVerified: identity.Email != "",
+				Type:          "apple",
+				ProviderID:    identity.Subject,
+			}
+		}
+	case "google":
+		profile, err = services.VerifyGoogleIDToken(c.Context(), data.Token)
+	default:
+		return fiber.NewError(fiber.StatusNotFound, "Unknown provider")
+	}
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return linkAccount(c, profile)
+}
+
+func linkAccount(c *fiber.Ctx, profile *services.HandleOauthLoginStruct) error {
+	u := c.Locals("user").(*ent.User)
+
+	a, err := services.LinkOAuthAccount(c.Context(), u, profile, services.GetSessionMetadata(c))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(a)
+}
+
+func UnlinkAccount(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid account id")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	err = services.UnlinkAccount(c.Context(), u, id, services.GetSessionMetadata(c))
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Account unlinked",
+	})
+}
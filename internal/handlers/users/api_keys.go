@@ -0,0 +1,82 @@
+package users_handlers
+
+import (
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" validate:"required,min=1,max=100"`
+	Scopes    []string   `json:"scopes" validate:"required,min=1,dive,oneof=users:read users:write"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	*ent.APIKey
+	// Key is only ever returned on creation
+	Key string `json:"key"`
+}
+
+func CreateAPIKey(c *fiber.Ctx) error {
+	data := new(CreateAPIKeyRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	if data.ExpiresAt != nil && !data.ExpiresAt.After(time.Now()) {
+		return fiber.NewError(fiber.StatusBadRequest, "Expiry must be in the future")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	key, raw, err := services.CreateAPIKey(c.Context(), u, data.Name, data.Scopes, data.ExpiresAt)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(CreateAPIKeyResponse{
+		APIKey: key,
+		Key:    raw,
+	})
+}
+
+func GetAPIKeys(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	keys, err := services.ListAPIKeys(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(keys)
+}
+
+func DeleteAPIKey(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid API key id")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	err = services.DeleteAPIKey(c.Context(), u, id)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "API key deleted",
+	})
+}
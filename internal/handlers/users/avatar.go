@@ -0,0 +1,66 @@
+package users_handlers
+
+import (
+	"io"
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// UploadAvatar replaces the profile picture. The format is sniffed from the
+// data, the declared content type and file name are ignored.
+func UploadAvatar(c *fiber.Ctx) error {
+	var data []byte
+
+	// Accept a multipart form with an avatar file, or the image as the raw body
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		file, err := c.FormFile("avatar")
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "avatar file is required")
+		}
+		if file.Size > int64(config.GetAvatarMaxBytes()) {
+			return fiber.NewError(fiber.StatusRequestEntityTooLarge, "Avatar is too large")
+		}
+		f, err := file.Open()
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid avatar file")
+		}
+		defer f.Close()
+		data, err = io.ReadAll(f)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, "Invalid avatar file")
+		}
+	} else {
+		data = c.Body()
+	}
+
+	if len(data) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "avatar file is required")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	pro, err := services.SetAvatar(c.Context(), u, data)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(pro)
+}
+
+func DeleteAvatar(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	pro, err := services.RemoveAvatar(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(pro)
+}
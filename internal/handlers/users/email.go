@@ -0,0 +1,68 @@
+package users_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+func ChangeEmail(c *fiber.Ctx) error {
+	type ChangeEmailRequest struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+	data := new(ChangeEmailRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	change, err := services.RequestEmailChange(c.Context(), u, data.Email)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"pendingEmail": change.Value,
+		"expiresAt":    change.ExpiresAt,
+	})
+}
+
+func VerifyEmailChange(c *fiber.Ctx) error {
+	type VerifyEmailChangeRequest struct {
+		Code string `json:"code" validate:"required"`
+	}
+	data := new(VerifyEmailChangeRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+	s, _ := c.Locals("session").(*ent.Session)
+	claims, _ := c.Locals("token_claims").(*tokens.AccessClaims)
+
+	u, err = services.ConfirmEmailChange(c.Context(), u, data.Code, s, claims)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(u)
+}
@@ -0,0 +1,110 @@
+package users_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+)
+
+type TOTPCodeRequest struct {
+	Code string `json:"code" validate:"required,numeric,len=6"`
+}
+
+type DisableTOTPRequest struct {
+	Code         string `json:"code" validate:"required_without=RecoveryCode,omitempty,numeric,len=6"`
+	RecoveryCode string `json:"recoveryCode" validate:"required_without=Code"`
+}
+
+// EnrollTOTP starts two-factor enrollment. The secret only becomes active once confirmed.
+func EnrollTOTP(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	secret, uri, err := services.BeginTOTPEnrollment(c.Context(), u)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"secret": secret,
+		"uri":    uri,
+	})
+}
+
+func ConfirmTOTP(c *fiber.Ctx) error {
+	data := new(TOTPCodeRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	codes, err := services.ConfirmTOTPEnrollment(c.Context(), u, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"recoveryCodes": codes,
+	})
+}
+
+func DisableTOTP(c *fiber.Ctx) error {
+	data := new(DisableTOTPRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	err := services.DisableTOTP(c.Context(), u, data.Code, data.RecoveryCode)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "success",
+	})
+}
+
+func RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	data := new(TOTPCodeRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	codes, err := services.RegenerateRecoveryCodes(c.Context(), u, data.Code)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"recoveryCodes": codes,
+	})
+}
@@ -0,0 +1,83 @@
+package users_handlers
+
+import (
+	"encoding/json"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type FinishPasskeyRegistrationRequest struct {
+	Name string `json:"name" validate:"required,min=1,max=100"`
+	// Credential is the PublicKeyCredential returned by navigator.credentials.create
+	Credential json.RawMessage `json:"credential" validate:"required"`
+}
+
+func BeginPasskeyRegistration(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	creation, err := services.BeginPasskeyRegistration(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(creation)
+}
+
+func FinishPasskeyRegistration(c *fiber.Ctx) error {
+	data := new(FinishPasskeyRegistrationRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	if err := validator.Validate(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	pk, err := services.FinishPasskeyRegistration(c.Context(), u, data.Name, data.Credential)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(pk)
+}
+
+func GetPasskeys(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	passkeys, err := services.ListPasskeys(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(passkeys)
+}
+
+func DeletePasskey(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid passkey id")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	err = services.DeletePasskey(c.Context(), u, id)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Passkey deleted",
+	})
+}
@@ -0,0 +1,80 @@
+package users_handlers
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SessionResponse struct {
+	*ent.Session
+	Current bool `json:"current"`
+}
+
+// currentSessionID returns the ID of the session used for this request, if any
+func currentSessionID(c *fiber.Ctx) uuid.UUID {
+	if s, ok := c.Locals("session").(*ent.Session); ok {
+		return s.ID
+	}
+	return uuid.Nil
+}
+
+func GetSessions(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	sessions, err := services.ListActiveSessions(c.Context(), u)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	currentID := currentSessionID(c)
+	response := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		response = append(response, SessionResponse{
+			Session: s,
+			Current: s.ID == currentID,
+		})
+	}
+
+	return c.JSON(response)
+}
+
+func RevokeSession(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid session id")
+	}
+
+	u := c.Locals("user").(*ent.User)
+
+	err = services.RevokeSession(c.Context(), u, id)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	if id == currentSessionID(c) {
+		c.ClearCookie("session")
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Session revoked",
+	})
+}
+
+func RevokeOtherSessions(c *fiber.Ctx) error {
+	u := c.Locals("user").(*ent.User)
+
+	revoked, err := services.RevokeOtherSessions(c.Context(), u, currentSessionID(c))
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Other sessions revoked",
+		"revoked": revoked,
+	})
+}
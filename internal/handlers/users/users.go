@@ -6,6 +6,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/NikSchaefer/go-fiber/ent"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/services"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
 )
 
@@ -26,24 +27,54 @@ func UpdateUser(c *fiber.Ctx) error {
 
 	u := c.Locals("user").(*ent.User)
 
-	update := u.Update()
 	if data.PhoneNumber == nil {
-		update.ClearPhoneNumber()
-	} else {
-		if *data.PhoneNumber == u.PhoneNumber {
-			return c.JSON(u)
+		u, err = services.RemovePhoneNumber(c.Context(), u)
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 		}
+		return c.JSON(u)
+	}
 
-		err := validator.ValidatePhoneUniqueness(c.Context(), database.DB, *data.PhoneNumber)
-		if err != nil {
-			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	if *data.PhoneNumber == u.PhoneNumber {
+		return c.JSON(u)
+	}
+
+	// The new number only replaces the current one once confirmed at /users/phone/verify
+	change, err := services.RequestPhoneChange(c.Context(), u, *data.PhoneNumber)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
 		}
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"pendingPhoneNumber": change.Value,
+		"expiresAt":          change.ExpiresAt,
+	})
+}
 
-		update.SetPhoneNumber(*data.PhoneNumber)
+func VerifyPhoneNumber(c *fiber.Ctx) error {
+	type VerifyPhoneNumberRequest struct {
+		Code string `json:"code" validate:"required"`
+	}
+	data := new(VerifyPhoneNumberRequest)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid JSON format")
+	}
+
+	err := validator.Validate(data)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
 	}
 
-	_, err = update.Save(c.Context())
+	u := c.Locals("user").(*ent.User)
+
+	u, err = services.ConfirmPhoneChange(c.Context(), u, data.Code)
 	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			return err
+		}
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
 	}
 
@@ -88,6 +119,11 @@ func DeleteUser(c *fiber.Ctx) error {
 	db := database.DB
 	u := c.Locals("user").(*ent.User)
 
+	// Uploaded files outlive the profile row, remove them first
+	if _, err := services.RemoveAvatar(c.Context(), u); err != nil && !ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+
 	err := db.User.DeleteOneID(u.ID).Exec(c.Context())
 	if err != nil {
 		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
@@ -1,23 +1,78 @@
 package middleware
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/NikSchaefer/go-fiber/internal/services"
 )
 
-// Authenticated middleware verifies that a user has a valid session and is verified
+// Authenticated middleware verifies that a user has a valid bearer access token,
+// API key or unexpired session cookie. Session expiry is slid forward on activity.
+// Users who haven't verified their email are held to EMAIL_VERIFICATION_POLICY.
 func Authenticated(c *fiber.Ctx) error {
 	// check if user is already in locals (Authenticated via other methods)
 	if c.Locals("user") != nil {
 		return c.Next()
 	}
 
-	user, err := services.ValidateSession(c.Context(), c.Cookies("session"))
+	if header := c.Get(fiber.HeaderAuthorization); header != "" {
+		accessToken := strings.TrimPrefix(header, "Bearer ")
+		if accessToken == header {
+			return fiber.NewError(fiber.StatusUnauthorized, "Unsupported authorization scheme")
+		}
+
+		user, claims, err := services.ValidateAccessToken(c.Context(), accessToken)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEmailVerified(c, user); err != nil {
+			return err
+		}
+
+		c.Locals("auth_type", "bearer")
+		c.Locals("token_claims", claims)
+		c.Locals("user", user)
+		return c.Next()
+	}
+
+	if rawKey := c.Get("X-API-Key"); rawKey != "" {
+		key, err := services.ValidateAPIKey(c.Context(), rawKey)
+		if err != nil {
+			return err
+		}
+
+		if err := checkEmailVerified(c, key.Edges.User); err != nil {
+			return err
+		}
+
+		c.Locals("auth_type", "api_key")
+		c.Locals("api_key", key)
+		c.Locals("user", key.Edges.User)
+		return c.Next()
+	}
+
+	token := c.Cookies("session")
+	s, err := services.ValidateSession(c.Context(), token)
 	if err != nil {
 		return err
 	}
 
+	if err := checkEmailVerified(c, s.Edges.User); err != nil {
+		return err
+	}
+
+	s, renewed, err := services.TouchSession(c.Context(), s)
+	if err != nil {
+		return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+	}
+	if renewed {
+		services.SetSessionCookie(c, token, s)
+	}
+
 	c.Locals("auth_type", "session")
-	c.Locals("user", user)
+	c.Locals("session", s)
+	c.Locals("user", s.Edges.User)
 	return c.Next()
 }
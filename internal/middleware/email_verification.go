@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/gofiber/fiber/v2"
+)
+
+// EmailVerificationRequiredError is returned when an unverified user calls a
+// route the verification policy doesn't allow. The client should have the user
+// verify their address with POST /auth/email/verify.
+type EmailVerificationRequiredError struct{}
+
+func (e *EmailVerificationRequiredError) Error() string {
+	return "Email verification required"
+}
+
+// checkEmailVerified applies EMAIL_VERIFICATION_POLICY to the authenticated user
+func checkEmailVerified(c *fiber.Ctx, u *ent.User) error {
+	if u.EmailVerified {
+		return nil
+	}
+
+	switch config.GetEmailVerificationPolicy() {
+	case "off":
+		return nil
+	case "block":
+		return &EmailVerificationRequiredError{}
+	}
+
+	route := c.Method() + " " + strings.TrimSuffix(c.Path(), "/")
+	for _, allowed := range config.GetEmailVerificationAllowedRoutes() {
+		allowed = strings.TrimSuffix(allowed, "/")
+		if prefix := strings.TrimSuffix(allowed, "*"); prefix != allowed {
+			if strings.HasPrefix(route, prefix) {
+				return nil
+			}
+		} else if route == allowed {
+			return nil
+		}
+	}
+
+	return &EmailVerificationRequiredError{}
+}
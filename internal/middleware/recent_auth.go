@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ReauthRequiredError is returned when a sensitive operation needs the user to
+// prove their identity again. The client should prompt for one of the methods
+// and call POST /auth/reauthenticate before retrying.
+type ReauthRequiredError struct {
+	Methods []string
+}
+
+func (e *ReauthRequiredError) Error() string {
+	return "Recent authentication required"
+}
+
+// RequireRecentAuth only lets the request through if the user authenticated
+// within maxAge on the current session or token family. API keys never pass.
+func RequireRecentAuth(maxAge time.Duration) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals("auth_type") == "api_key" {
+			return fiber.NewError(fiber.StatusForbidden, "API keys cannot perform this operation")
+		}
+
+		s, _ := c.Locals("session").(*ent.Session)
+		claims, _ := c.Locals("token_claims").(*tokens.AccessClaims)
+
+		authenticatedAt, err := services.GetAuthenticatedAt(c.Context(), s, claims)
+		if err != nil {
+			if _, ok := err.(*fiber.Error); ok {
+				return err
+			}
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+
+		if time.Since(authenticatedAt) <= maxAge {
+			return c.Next()
+		}
+
+		methods, err := services.GetReauthMethods(c.Context(), c.Locals("user").(*ent.User))
+		if err != nil {
+			return fiber.NewError(fiber.StatusInternalServerError, "Internal Server Error: "+err.Error())
+		}
+
+		return &ReauthRequiredError{Methods: methods}
+	}
+}
+
+// ErrorHandler renders ReauthRequiredError, EmailVerificationRequiredError and
+// services.AccountLinkRequiredError as machine readable responses and leaves
+// every other error to fiber's default handler
+func ErrorHandler(c *fiber.Ctx, err error) error {
+	if link, ok := err.(*services.AccountLinkRequiredError); ok {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":     "account_link_required",
+			"message":   link.Error(),
+			"linkToken": link.Token,
+			"email":     link.Email,
+			"methods":   link.Methods,
+			"expiresAt": link.ExpiresAt,
+		})
+	}
+	if verification, ok := err.(*EmailVerificationRequiredError); ok {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "email_verification_required",
+			"message": verification.Error(),
+		})
+	}
+	if reauth, ok := err.(*ReauthRequiredError); ok {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "reauthentication_required",
+			"message": reauth.Error(),
+			"methods": reauth.Methods,
+		})
+	}
+	return fiber.DefaultErrorHandler(c, err)
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// RequireScope limits API key requests to keys granted the scope. Sessions and
+// bearer tokens act with the user's full authority and always pass.
+func RequireScope(scope string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if c.Locals("auth_type") != "api_key" {
+			return c.Next()
+		}
+
+		key, ok := c.Locals("api_key").(*ent.APIKey)
+		if !ok || !services.HasScope(key, scope) {
+			return fiber.NewError(fiber.StatusForbidden, "API key is missing the required scope: "+scope)
+		}
+
+		return c.Next()
+	}
+}
+
+// DenyAPIKeys keeps API keys away from routes that manage credentials, so a
+// leaked key can't mint new keys or enroll an attacker's second factor or passkey
+func DenyAPIKeys(c *fiber.Ctx) error {
+	if c.Locals("auth_type") == "api_key" {
+		return fiber.NewError(fiber.StatusForbidden, "API keys cannot manage credentials")
+	}
+	return c.Next()
+}
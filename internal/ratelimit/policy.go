@@ -0,0 +1,143 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+// State is what a store keeps per key. Policies decide what the values mean.
+type State struct {
+	Value    float64
+	Previous float64
+	Stamp    time.Time
+}
+
+// Result is the outcome of one request against a policy
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is the time until the limit is fully restored
+	Reset time.Duration
+	// RetryAfter is the time until the next request would be allowed, zero if allowed
+	RetryAfter time.Duration
+}
+
+// Policy is a rate limiting algorithm
+type Policy interface {
+	// Apply counts one request against the stored state and returns the new state.
+	// exists is false for keys without state yet.
+	Apply(state State, exists bool, now time.Time) (State, Result)
+	// TTL is how long state has to be kept after the last request
+	TTL() time.Duration
+}
+
+// TokenBucket allows bursts of up to Capacity requests, refilled evenly at
+// Capacity tokens per Period
+type TokenBucket struct {
+	Capacity int
+	Period   time.Duration
+}
+
+// Apply stores the tokens left in Value and the last refill in Stamp
+func (p TokenBucket) Apply(state State, exists bool, now time.Time) (State, Result) {
+	capacity := float64(p.Capacity)
+	perToken := p.Period / time.Duration(p.Capacity)
+
+	tokens := capacity
+	if exists {
+		elapsed := now.Sub(state.Stamp)
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		tokens = math.Min(capacity, state.Value+elapsed.Seconds()/perToken.Seconds())
+	}
+
+	result := Result{Limit: p.Capacity}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(perToken))
+	}
+
+	result.Remaining = int(math.Floor(tokens))
+	result.Reset = time.Duration((capacity - tokens) * float64(perToken))
+
+	return State{Value: tokens, Stamp: now}, result
+}
+
+func (p TokenBucket) TTL() time.Duration {
+	return p.Period
+}
+
+// SlidingWindow allows Limit requests per Window, approximating a true sliding
+// window by weighting the previous fixed window by how much of it still overlaps
+type SlidingWindow struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Apply stores the current window's count in Value, the previous window's in
+// Previous and the current window's start in Stamp
+func (p SlidingWindow) Apply(state State, exists bool, now time.Time) (State, Result) {
+	start := now.Truncate(p.Window)
+
+	current, previous := 0.0, 0.0
+	if exists {
+		switch start.Sub(state.Stamp) {
+		case 0:
+			current, previous = state.Value, state.Previous
+		case p.Window:
+			previous = state.Value
+		}
+	}
+
+	overlap := 1 - float64(now.Sub(start))/float64(p.Window)
+	weighted := previous*overlap + current
+
+	result := Result{Limit: p.Limit}
+	if weighted+1 <= float64(p.Limit) {
+		current++
+		weighted++
+		result.Allowed = true
+	} else {
+		result.RetryAfter = p.retryAfter(now, start, current, previous)
+	}
+
+	result.Remaining = int(math.Max(0, math.Floor(float64(p.Limit)-weighted)))
+	// Requests in the current window still count through the whole next one
+	result.Reset = start.Add(2 * p.Window).Sub(now)
+	if current == 0 {
+		result.Reset = start.Add(p.Window).Sub(now)
+	}
+
+	return State{Value: current, Previous: previous, Stamp: start}, result
+}
+
+// retryAfter solves for when the previous window's weight has decayed enough
+// for one more request
+func (p SlidingWindow) retryAfter(now, start time.Time, current, previous float64) time.Duration {
+	limit := float64(p.Limit)
+	end := start.Add(p.Window)
+
+	if current+1 > limit || previous == 0 {
+		// Has to wait for this window to become the previous one, then for it to decay
+		needed := 1 - (limit-1)/math.Max(current, 1)
+		if needed < 0 {
+			needed = 0
+		}
+		return end.Sub(now) + time.Duration(needed*float64(p.Window))
+	}
+
+	overlap := (limit - 1 - current) / previous
+	at := start.Add(time.Duration((1 - overlap) * float64(p.Window)))
+	if at.Before(now) {
+		return 0
+	}
+	return at.Sub(now)
+}
+
+func (p SlidingWindow) TTL() time.Duration {
+	return 2 * p.Window
+}
@@ -0,0 +1,112 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	p := TokenBucket{Capacity: 4, Period: 4 * time.Second}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	state, result := p.Apply(State{}, false, now)
+	if !result.Allowed || result.Remaining != 3 || result.Reset != time.Second {
+		t.Fatalf("first request = %+v", result)
+	}
+
+	// The burst drains the bucket without waiting
+	for i := 0; i < 3; i++ {
+		state, result = p.Apply(state, true, now)
+	}
+	if !result.Allowed || result.Remaining != 0 || result.Reset != 4*time.Second {
+		t.Fatalf("last token = %+v", result)
+	}
+
+	rejected, result := p.Apply(state, true, now)
+	if result.Allowed || result.RetryAfter != time.Second {
+		t.Fatalf("empty bucket = %+v", result)
+	}
+	if rejected.Value != 0 {
+		t.Errorf("rejected request took a token, %v left", rejected.Value)
+	}
+
+	// One token comes back per second
+	_, result = p.Apply(state, true, now.Add(2500*time.Millisecond))
+	if !result.Allowed || result.Remaining != 1 {
+		t.Errorf("after 2.5s = %+v", result)
+	}
+	_, result = p.Apply(state, true, now.Add(500*time.Millisecond))
+	if result.Allowed || result.RetryAfter != 500*time.Millisecond {
+		t.Errorf("after 0.5s = %+v", result)
+	}
+
+	// Never refills past capacity
+	_, result = p.Apply(state, true, now.Add(time.Hour))
+	if !result.Allowed || result.Remaining != 3 {
+		t.Errorf("after an hour = %+v", result)
+	}
+
+	// A clock that went backwards refills nothing
+	_, result = p.Apply(state, true, now.Add(-time.Hour))
+	if result.Allowed {
+		t.Errorf("clock skew = %+v", result)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	p := SlidingWindow{Limit: 10, Window: time.Minute}
+	start := time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC)
+	// The previous window was used up
+	full := State{Value: 10, Stamp: start.Add(-time.Minute)}
+
+	tests := []struct {
+		name          string
+		state         State
+		now           time.Time
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+	}{
+		// A quarter into the window, three quarters of the previous one still count
+		{name: "previous window weighted", state: full, now: start.Add(15 * time.Second), wantAllowed: true, wantRemaining: 1},
+		{
+			name:        "previous and current window together",
+			state:       State{Value: 2, Previous: 10, Stamp: start},
+			now:         start.Add(15 * time.Second),
+			wantAllowed: false,
+			// 10 * overlap + 2 + 1 <= 10 once the overlap is down to 0.7
+			wantRetry: 3 * time.Second,
+		},
+		{name: "previous window decayed", state: State{Value: 2, Previous: 10, Stamp: start}, now: start.Add(45 * time.Second), wantAllowed: true, wantRemaining: 4},
+		{name: "stale state", state: State{Value: 10, Stamp: start.Add(-2 * time.Minute)}, now: start, wantAllowed: true, wantRemaining: 9},
+		{name: "current window full", state: State{Value: 10, Stamp: start}, now: start.Add(30 * time.Second), wantAllowed: false, wantRetry: 30*time.Second + 6*time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, result := p.Apply(tt.state, true, tt.now)
+			if result.Allowed != tt.wantAllowed {
+				t.Fatalf("allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if tt.wantAllowed && result.Remaining != tt.wantRemaining {
+				t.Errorf("remaining = %d, want %d", result.Remaining, tt.wantRemaining)
+			}
+			if !tt.wantAllowed && result.RetryAfter.Round(time.Millisecond) != tt.wantRetry {
+				t.Errorf("retry after = %v, want %v", result.RetryAfter, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestSlidingWindowMovesCountToPrevious(t *testing.T) {
+	p := SlidingWindow{Limit: 10, Window: time.Minute}
+	start := time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC)
+
+	state, _ := p.Apply(State{Value: 6, Previous: 4, Stamp: start}, true, start.Add(59*time.Second))
+	state, _ = p.Apply(state, true, start.Add(time.Minute))
+
+	want := State{Value: 1, Previous: 7, Stamp: start.Add(time.Minute)}
+	if state != want {
+		t.Errorf("state = %+v, want %+v", state, want)
+	}
+}
@@ -0,0 +1,118 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/ratelimitbucket"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+)
+
+// postgresRetries bounds how often a key is retried when replicas race to create it
+const postgresRetries = 5
+
+// ErrContention is returned when a key could not be created or locked within the retries
+var ErrContention = errors.New("rate limit state changed concurrently too often")
+
+// PostgresStore keeps state in the database so every replica enforces the same
+// limits. Each take locks the key's row for the length of a transaction, so
+// concurrent requests against one key are counted one after another.
+type PostgresStore struct {
+	db *ent.Client
+}
+
+// NewPostgresStore creates a database backed store that sweeps expired keys in the background
+func NewPostgresStore(db *ent.Client) *PostgresStore {
+	s := &PostgresStore{db: db}
+	go s.sweep()
+	return s
+}
+
+func (s *PostgresStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	for i := 0; i < postgresRetries; i++ {
+		result, err := s.take(ctx, key, policy)
+		if ent.IsConstraintError(err) {
+			// Another replica created the key first, apply against its state
+			continue
+		}
+		return result, err
+	}
+
+	return Result{}, ErrContention
+}
+
+func (s *PostgresStore) take(ctx context.Context, key string, policy Policy) (Result, error) {
+	tx, err := s.db.Tx(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	// Bumping the version takes the row lock until commit, the same as
+	// SELECT ... FOR UPDATE
+	locked, err := tx.RateLimitBucket.Update().
+		Where(ratelimitbucket.Key(key)).
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
+		return Result{}, utils.RollbackTx(tx, err)
+	}
+
+	now := time.Now()
+
+	if locked == 0 {
+		state, result := policy.Apply(State{}, false, now)
+
+		err = tx.RateLimitBucket.Create().
+			SetKey(key).
+			SetValue(state.Value).
+			SetPrevious(state.Previous).
+			SetStamp(state.Stamp).
+			SetExpiresAt(now.Add(policy.TTL())).
+			Exec(ctx)
+		if err != nil {
+			return Result{}, utils.RollbackTx(tx, err)
+		}
+		return result, tx.Commit()
+	}
+
+	bucket, err := tx.RateLimitBucket.Query().
+		Where(ratelimitbucket.Key(key)).
+		Only(ctx)
+	if err != nil {
+		return Result{}, utils.RollbackTx(tx, err)
+	}
+
+	current := State{
+		Value:    bucket.Value,
+		Previous: bucket.Previous,
+		Stamp:    bucket.Stamp,
+	}
+	exists := now.Before(bucket.ExpiresAt)
+	state, result := policy.Apply(current, exists, now)
+
+	err = tx.RateLimitBucket.UpdateOne(bucket).
+		SetValue(state.Value).
+		SetPrevious(state.Previous).
+		SetStamp(state.Stamp).
+		SetExpiresAt(now.Add(policy.TTL())).
+		Exec(ctx)
+	if err != nil {
+		return Result{}, utils.RollbackTx(tx, err)
+	}
+
+	return result, tx.Commit()
+}
+
+func (s *PostgresStore) sweep() {
+	for range time.Tick(sweepInterval) {
+		_, err := s.db.RateLimitBucket.Delete().
+			Where(ratelimitbucket.ExpiresAtLT(time.Now())).
+			Exec(context.Background())
+		if err != nil {
+			log.Printf("Failed to sweep rate limit buckets: %v", err)
+		}
+	}
+}
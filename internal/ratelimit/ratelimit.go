@@ -0,0 +1,132 @@
+// Package ratelimit limits request rates per client, user or contact address.
+// Policies are attached to routes with New and share a Store, which is in
+// memory for a single instance or in Postgres when running several replicas.
+package ratelimit
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// KeyFunc derives the key a request is counted against. An empty key skips the limit.
+type KeyFunc func(c *fiber.Ctx) string
+
+// Config declares one limit
+type Config struct {
+	// Name namespaces the keys, so policies sharing a key function count separately
+	Name   string
+	Policy Policy
+	Key    KeyFunc
+	Store  Store
+}
+
+// NewStore creates the store selected by RATE_LIMIT_STORE
+func NewStore() Store {
+	if config.GetRateLimitStore() == "postgres" {
+		return NewPostgresStore(database.DB)
+	}
+	return NewMemoryStore()
+}
+
+// New returns a middleware enforcing the limit. Responses carry RateLimit-Limit,
+// RateLimit-Remaining and RateLimit-Reset of the most restrictive limit on the
+// route, and Retry-After when rejected.
+func New(cfg Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		key := cfg.Key(c)
+		if key == "" {
+			return c.Next()
+		}
+
+		result, err := cfg.Store.Take(c.Context(), cfg.Name+":"+key, cfg.Policy)
+		if errors.Is(err, ErrContention) {
+			// A key this busy is being hammered, letting the requests through would defeat the limit
+			return fiber.NewError(fiber.StatusTooManyRequests, "Too many requests, try again later")
+		}
+		if err != nil {
+			// Fail open, an unavailable store should not take the API down with it
+			log.Printf("Rate limit %s unavailable: %v", cfg.Name, err)
+			return c.Next()
+		}
+
+		setHeaders(c, result)
+
+		if !result.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(seconds(result.RetryAfter)))
+			return fiber.NewError(fiber.StatusTooManyRequests, "Too many requests, try again later")
+		}
+
+		return c.Next()
+	}
+}
+
+// setHeaders reports the result unless an earlier limit on the route has less remaining
+func setHeaders(c *fiber.Ctx, result Result) {
+	if existing := c.Response().Header.Peek("RateLimit-Remaining"); len(existing) > 0 {
+		if remaining, err := strconv.Atoi(string(existing)); err == nil && remaining < result.Remaining {
+			return
+		}
+	}
+
+	c.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	c.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Set("RateLimit-Reset", strconv.Itoa(seconds(result.Reset)))
+}
+
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// ByIP keys requests by client IP, trusting X-Forwarded-For only from configured proxies
+func ByIP(c *fiber.Ctx) string {
+	return "ip:" + utils.ClientIP(
+		c.Context().RemoteIP().String(),
+		c.IPs(),
+		utils.ParseTrustedProxies(config.GetTrustedProxies()),
+	)
+}
+
+// ByUser keys requests by the authenticated user, falling back to the IP.
+// Must run after middleware.Authenticated.
+func ByUser(c *fiber.Ctx) string {
+	if u, ok := c.Locals("user").(*ent.User); ok {
+		return "user:" + u.ID.String()
+	}
+	return ByIP(c)
+}
+
+// ByEmail keys requests by the email address in the JSON body
+func ByEmail(c *fiber.Ctx) string {
+	if email := bodyField(c, "email"); email != "" {
+		return "email:" + strings.ToLower(email)
+	}
+	return ""
+}
+
+// ByPhone keys requests by the phone number in the JSON body
+func ByPhone(c *fiber.Ctx) string {
+	if phone := bodyField(c, "phone"); phone != "" {
+		return "phone:" + phone
+	}
+	return ""
+}
+
+func bodyField(c *fiber.Ctx, field string) string {
+	var body map[string]interface{}
+	if err := json.Unmarshal(c.Body(), &body); err != nil {
+		return ""
+	}
+	value, _ := body[field].(string)
+	return strings.TrimSpace(value)
+}
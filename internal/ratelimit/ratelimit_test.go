@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func fixedKey(key string) KeyFunc {
+	return func(c *fiber.Ctx) string { return key }
+}
+
+func TestMiddlewareRejectsOverLimit(t *testing.T) {
+	store := &MemoryStore{entries: make(map[string]*memoryEntry)}
+
+	app := fiber.New()
+	app.Get("/",
+		New(Config{Name: "test", Policy: TokenBucket{Capacity: 2, Period: time.Minute}, Key: fixedKey("k"), Store: store}),
+		func(c *fiber.Ctx) error { return c.SendString("ok") },
+	)
+
+	get := func() *http.Response {
+		t.Helper()
+		resp, err := app.Test(httptest.NewRequest("GET", "/", nil), -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	first := get()
+	if first.StatusCode != fiber.StatusOK {
+		t.Fatalf("first request = %d", first.StatusCode)
+	}
+	expectHeaders(t, first.Header, map[string]string{
+		"RateLimit-Limit":     "2",
+		"RateLimit-Remaining": "1",
+		"RateLimit-Reset":     "30",
+		"Retry-After":         "",
+	})
+
+	get()
+	rejected := get()
+	if rejected.StatusCode != fiber.StatusTooManyRequests {
+		t.Fatalf("third request = %d, want 429", rejected.StatusCode)
+	}
+	expectHeaders(t, rejected.Header, map[string]string{
+		"RateLimit-Limit":     "2",
+		"RateLimit-Remaining": "0",
+		"RateLimit-Reset":     "60",
+		"Retry-After":         "30",
+	})
+}
+
+func TestMiddlewareReportsMostRestrictiveLimit(t *testing.T) {
+	store := &MemoryStore{entries: make(map[string]*memoryEntry)}
+
+	app := fiber.New()
+	app.Get("/",
+		New(Config{Name: "tight", Policy: SlidingWindow{Limit: 3, Window: time.Hour}, Key: fixedKey("k"), Store: store}),
+		New(Config{Name: "loose", Policy: TokenBucket{Capacity: 100, Period: time.Minute}, Key: fixedKey("k"), Store: store}),
+		func(c *fiber.Ctx) error { return c.SendString("ok") },
+	)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/", nil), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resp.Header.Get("RateLimit-Limit"); got != "3" {
+		t.Errorf("RateLimit-Limit = %q, want the tighter limit's 3", got)
+	}
+	if got := resp.Header.Get("RateLimit-Remaining"); got != "2" {
+		t.Errorf("RateLimit-Remaining = %q, want 2", got)
+	}
+}
+
+func TestMiddlewareSkipsEmptyKey(t *testing.T) {
+	store := &MemoryStore{entries: make(map[string]*memoryEntry)}
+
+	app := fiber.New()
+	app.Get("/",
+		New(Config{Name: "test", Policy: TokenBucket{Capacity: 1, Period: time.Minute}, Key: fixedKey(""), Store: store}),
+		func(c *fiber.Ctx) error { return c.SendString("ok") },
+	)
+
+	for i := 0; i < 3; i++ {
+		resp, err := app.Test(httptest.NewRequest("GET", "/", nil), -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != fiber.StatusOK || resp.Header.Get("RateLimit-Limit") != "" {
+			t.Fatalf("request %d = %d, limit %q", i+1, resp.StatusCode, resp.Header.Get("RateLimit-Limit"))
+		}
+	}
+}
+
+func expectHeaders(t *testing.T, header http.Header, want map[string]string) {
+	t.Helper()
+
+	for name, value := range want {
+		if got := header.Get(name); got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Store keeps rate limit state per key
+type Store interface {
+	// Take atomically applies the policy to the key's state for one request
+	Take(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// sweepInterval is how often stores delete state that has outlived its TTL
+const sweepInterval = 10 * time.Minute
+
+type memoryEntry struct {
+	state     State
+	expiresAt time.Time
+}
+
+// MemoryStore keeps state in process. Limits are per instance, so only use it
+// when running a single replica.
+type MemoryStore struct {
+	mu      sync.Mutex
+	entries map[string]*memoryEntry
+}
+
+// NewMemoryStore creates an in-process store that sweeps expired keys in the background
+func NewMemoryStore() *MemoryStore {
+	s := &MemoryStore{entries: make(map[string]*memoryEntry)}
+	go s.sweep()
+	return s
+}
+
+func (s *MemoryStore) Take(ctx context.Context, key string, policy Policy) (Result, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entries[key]
+	if exists && now.After(entry.expiresAt) {
+		exists = false
+	}
+
+	var current State
+	if exists {
+		current = entry.state
+	}
+
+	state, result := policy.Apply(current, exists, now)
+	s.entries[key] = &memoryEntry{
+		state:     state,
+		expiresAt: now.Add(policy.TTL()),
+	}
+
+	return result, nil
+}
+
+func (s *MemoryStore) sweep() {
+	for range time.Tick(sweepInterval) {
+		now := time.Now()
+		s.mu.Lock()
+		for key, entry := range s.entries {
+			if now.After(entry.expiresAt) {
+				delete(s.entries, key)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,35 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	s := &MemoryStore{entries: make(map[string]*memoryEntry)}
+	p := TokenBucket{Capacity: 2, Period: time.Hour}
+
+	for i := 0; i < 2; i++ {
+		if result, _ := s.Take(t.Context(), "k", p); !result.Allowed {
+			t.Fatalf("request %d rejected", i+1)
+		}
+	}
+	if result, _ := s.Take(t.Context(), "k", p); result.Allowed {
+		t.Fatal("drained bucket allowed a request")
+	}
+
+	// Other keys are counted separately
+	if result, _ := s.Take(t.Context(), "other", p); !result.Allowed {
+		t.Fatal("other key rejected")
+	}
+
+	// Past its TTL the state is forgotten, even though the bucket would still be empty
+	s.entries["k"].expiresAt = time.Now().Add(-time.Second)
+	result, _ := s.Take(t.Context(), "k", p)
+	if !result.Allowed || result.Remaining != 1 {
+		t.Errorf("after expiry = %+v", result)
+	}
+	if expiresAt := s.entries["k"].expiresAt; time.Until(expiresAt) <= 59*time.Minute {
+		t.Errorf("expiry not extended by the policy's TTL, expires in %v", time.Until(expiresAt))
+	}
+}
@@ -1,9 +1,14 @@
 package router
 
 import (
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
 	auth_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/auth"
 	user_handlers "github.com/NikSchaefer/go-fiber/internal/handlers/users"
 	"github.com/NikSchaefer/go-fiber/internal/middleware"
+	"github.com/NikSchaefer/go-fiber/internal/ratelimit"
+	"github.com/NikSchaefer/go-fiber/internal/services"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -14,35 +19,132 @@ func Initialize(router *fiber.App) {
 		return c.Status(200).SendString("Hello, World!")
 	})
 
-	auth := router.Group("/auth")
+	// Public keys for verifying access tokens
+	router.Get("/.well-known/jwks.json", auth_handlers.GetJWKS)
+
+	// Uploaded files, when they are kept on this server
+	if config.GetStorageDriver() == "local" {
+		router.Static("/uploads", config.GetStorageLocalDir())
+	}
+
+	// API keys are limited to their scopes, sessions and bearer tokens pass every scope check
+	read := middleware.RequireScope(services.ScopeUsersRead)
+	write := middleware.RequireScope(services.ScopeUsersWrite)
+	// Credentials can only be managed from a session or bearer token, never with an API key
+	noKeys := middleware.DenyAPIKeys
+
+	// Sensitive operations need the user to have proven their identity recently
+	recent := middleware.RequireRecentAuth(config.GetReauthMaxAge())
+
+	// Rate limits, all sharing one store so they hold across replicas with RATE_LIMIT_STORE=postgres
+	limits := ratelimit.NewStore()
+	limit := func(name string, policy ratelimit.Policy, key ratelimit.KeyFunc) fiber.Handler {
+		return ratelimit.New(ratelimit.Config{Name: name, Policy: policy, Key: key, Store: limits})
+	}
+
+	// Every auth endpoint is limited per client, with stricter limits on the ones
+	// that guess credentials or send email and SMS
+	authByIP := limit("auth", ratelimit.SlidingWindow{Limit: 60, Window: time.Minute}, ratelimit.ByIP)
+	credentialsByIP := limit("credentials", ratelimit.SlidingWindow{Limit: 10, Window: time.Minute}, ratelimit.ByIP)
+	sendByIP := limit("send", ratelimit.SlidingWindow{Limit: 10, Window: time.Hour}, ratelimit.ByIP)
+	sendByEmail := limit("send-email", ratelimit.TokenBucket{Capacity: 3, Period: 15 * time.Minute}, ratelimit.ByEmail)
+	sendByPhone := limit("send-phone", ratelimit.TokenBucket{Capacity: 3, Period: 15 * time.Minute}, ratelimit.ByPhone)
+	signupByIP := limit("signup", ratelimit.SlidingWindow{Limit: 5, Window: time.Hour}, ratelimit.ByIP)
+
+	// Authenticated users get a generous burst that refills steadily
+	userLimit := limit("users", ratelimit.TokenBucket{Capacity: 120, Period: time.Minute}, ratelimit.ByUser)
+	sendByUser := limit("send-user", ratelimit.TokenBucket{Capacity: 3, Period: 15 * time.Minute}, ratelimit.ByUser)
+	// Each avatar upload decodes an image and writes every size to storage
+	avatarByUser := limit("avatar", ratelimit.TokenBucket{Capacity: 5, Period: time.Hour}, ratelimit.ByUser)
+
+	auth := router.Group("/auth", authByIP)
 	{
 		// Login related
-		auth.Post("/login/password", auth_handlers.LoginWithPassword)
-		auth.Post("/login/otp/request", auth_handlers.RequestLoginWithOTP)
-		auth.Post("/login/otp/verify", auth_handlers.VerifyLoginWithOTP)
+		auth.Post("/login/password", credentialsByIP, auth_handlers.LoginWithPassword)
+		auth.Post("/login/unlock", credentialsByIP, auth_handlers.UnlockLogin)
+		auth.Post("/login/otp/request", sendByIP, sendByEmail, sendByPhone, auth_handlers.RequestLoginWithOTP)
+		auth.Post("/login/otp/verify", credentialsByIP, auth_handlers.VerifyLoginWithOTP)
+		auth.Post("/login/magic/request", sendByIP, sendByEmail, auth_handlers.RequestMagicLink)
+		auth.Post("/login/magic/verify", credentialsByIP, auth_handlers.VerifyMagicLink)
+		auth.Post("/login/magic/poll", auth_handlers.PollMagicLink)
+		auth.Post("/login/mfa", credentialsByIP, auth_handlers.VerifyLoginWithMFA)
+		auth.Post("/login/passkey/begin", auth_handlers.BeginLoginWithPasskey)
+		auth.Post("/login/passkey/finish", auth_handlers.FinishLoginWithPasskey)
 		auth.Delete("/logout", middleware.Authenticated, auth_handlers.Logout)
 
+		// Step-up authentication for sensitive operations
+		auth.Post("/reauthenticate", credentialsByIP, middleware.Authenticated, auth_handlers.Reauthenticate)
+		auth.Post("/reauthenticate/otp", sendByIP, middleware.Authenticated, auth_handlers.RequestReauthOTP)
+
+		// Bearer token clients
+		auth.Post("/token/refresh", auth_handlers.RefreshToken)
+		auth.Post("/token/revoke", auth_handlers.RevokeToken)
+
 		// OAuth routes
-		auth.Post("/oauth/google", auth_handlers.GetGoogleAuthRedirect)
-		auth.Post("/oauth/google/callback", auth_handlers.GetGoogleAuthCallback)
+		// Apple and native logins are registered before the generic provider routes they would match
+		auth.Post("/oauth/apple", auth_handlers.GetAppleAuthRedirect)
+		auth.Post("/oauth/apple/callback", auth_handlers.GetAppleAuthCallback)
+		auth.Post("/oauth/apple/native/callback", auth_handlers.GetAppleNativeAuthCallback)
 		auth.Post("/oauth/google/native/callback", auth_handlers.GetGoogleNativeAuthCallback)
+		auth.Get("/oauth/providers", auth_handlers.GetOAuthProviders)
+		auth.Post("/oauth/link/code", sendByIP, auth_handlers.RequestAccountLinkCode)
+		auth.Post("/oauth/link/confirm", credentialsByIP, auth_handlers.ConfirmAccountLink)
+		auth.Post("/oauth/:provider", auth_handlers.GetOAuthRedirect)
+		auth.Post("/oauth/:provider/callback", auth_handlers.GetOAuthCallback)
 
 		// Registration
-		auth.Post("/signup", auth_handlers.SignUp)
+		auth.Post("/signup", signupByIP, auth_handlers.SignUp)
+		auth.Post("/email/verify", credentialsByIP, auth_handlers.VerifyEmail)
+		auth.Post("/email/verify/resend", sendByIP, sendByEmail, auth_handlers.ResendEmailVerification)
+		auth.Post("/email/revert", credentialsByIP, auth_handlers.RevertEmailChange)
 
 		// Password management
-		auth.Post("/password/change", middleware.Authenticated, auth_handlers.ChangePassword)
-		auth.Post("/password/reset/request", auth_handlers.ResetPassword)
-		auth.Post("/password/reset/verify", auth_handlers.VerifyResetPassword)
+		auth.Post("/password/change", credentialsByIP, middleware.Authenticated, noKeys, auth_handlers.ChangePassword)
+		auth.Post("/password/reset/request", sendByIP, sendByEmail, auth_handlers.ResetPassword)
+		auth.Post("/password/reset/verify", credentialsByIP, auth_handlers.VerifyResetPassword)
 	}
 
 	// User routes
-	user := router.Group("/users", middleware.Authenticated)
+	user := router.Group("/users", middleware.Authenticated, userLimit)
 	{
-		user.Get("/me", user_handlers.GetCurrentUserInfo)
-		user.Get("/profile", user_handlers.GetUserProfile)
-		user.Patch("/", user_handlers.UpdateUser)
-		user.Patch("/profile", user_handlers.UpdateProfile)
-		user.Delete("/", user_handlers.DeleteUser)
+		user.Get("/me", read, user_handlers.GetCurrentUserInfo)
+		user.Get("/profile", read, user_handlers.GetUserProfile)
+		user.Patch("/", write, recent, sendByUser, user_handlers.UpdateUser)
+		user.Post("/phone/verify", write, user_handlers.VerifyPhoneNumber)
+		user.Post("/email/change", write, recent, sendByUser, user_handlers.ChangeEmail)
+		user.Post("/email/verify", write, user_handlers.VerifyEmailChange)
+		user.Patch("/profile", write, user_handlers.UpdateProfile)
+		user.Put("/profile/avatar", write, avatarByUser, user_handlers.UploadAvatar)
+		user.Delete("/profile/avatar", write, user_handlers.DeleteAvatar)
+		user.Delete("/", write, recent, user_handlers.DeleteUser)
+
+		// Session management
+		user.Get("/sessions", read, user_handlers.GetSessions)
+		user.Delete("/sessions", noKeys, user_handlers.RevokeOtherSessions)
+		user.Delete("/sessions/:id", noKeys, user_handlers.RevokeSession)
+
+		// API keys
+		user.Post("/api-keys", noKeys, recent, user_handlers.CreateAPIKey)
+		user.Get("/api-keys", read, user_handlers.GetAPIKeys)
+		user.Delete("/api-keys/:id", noKeys, user_handlers.DeleteAPIKey)
+
+		// Two-factor authentication
+		user.Post("/mfa/totp", noKeys, recent, user_handlers.EnrollTOTP)
+		user.Post("/mfa/totp/confirm", noKeys, recent, user_handlers.ConfirmTOTP)
+		user.Delete("/mfa/totp", noKeys, recent, user_handlers.DisableTOTP)
+		user.Post("/mfa/recovery-codes", noKeys, recent, user_handlers.RegenerateRecoveryCodes)
+
+		// Linked sign-in methods
+		user.Get("/accounts", read, user_handlers.GetAccounts)
+		user.Post("/accounts/:provider/native", write, recent, user_handlers.LinkNativeAccount)
+		user.Post("/accounts/:provider/link", write, recent, user_handlers.BeginLinkAccount)
+		user.Post("/accounts/:provider/link/callback", write, recent, user_handlers.FinishLinkAccount)
+		user.Delete("/accounts/:id", write, recent, user_handlers.UnlinkAccount)
+
+		// Passkeys
+		user.Post("/passkeys/register/begin", noKeys, recent, user_handlers.BeginPasskeyRegistration)
+		user.Post("/passkeys/register/finish", noKeys, recent, user_handlers.FinishPasskeyRegistration)
+		user.Get("/passkeys", read, user_handlers.GetPasskeys)
+		user.Delete("/passkeys/:id", noKeys, recent, user_handlers.DeletePasskey)
 	}
 }
@@ -0,0 +1,209 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+const accountLinkTokenBytes = 32
+
+// AccountLinkRequiredError is returned by HandleOauthLogin when the provider
+// identity matches an existing user who has to confirm the link. The client
+// should prompt for one of the methods and call POST /auth/oauth/link/confirm.
+type AccountLinkRequiredError struct {
+	Token     string
+	Email     string
+	Methods   []string
+	ExpiresAt time.Time
+}
+
+func (e *AccountLinkRequiredError) Error() string {
+	return "An account with this email already exists, confirm it is yours to link this sign-in method"
+}
+
+// AccountLinkCredentials proves the user owns the account being linked to; exactly one is checked
+type AccountLinkCredentials struct {
+	Password string
+	Code     string
+}
+
+// requireAccountLink parks the provider identity until the user confirms it,
+// replacing any earlier pending link of the same provider
+func requireAccountLink(ctx context.Context, u *ent.User, data HandleOauthLoginStruct) error {
+	token, err := utils.GenerateToken(accountLinkTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.AccountLink.Delete().
+		Where(
+			accountlink.HasUserWith(user.IDEQ(u.ID)),
+			accountlink.TypeEQ(accountlink.Type(data.Type)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	link, err := tx.AccountLink.Create().
+		SetUser(u).
+		SetTokenHash(utils.HashToken(token)).
+		SetType(accountlink.Type(data.Type)).
+		SetProviderID(data.ProviderID).
+		Save(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	methods, err := GetReauthMethods(ctx, u)
+	if err != nil {
+		return err
+	}
+	// TOTP alone doesn't prove control of the account, it is a second factor
+	var linkMethods []string
+	for _, method := range methods {
+		if method != ReauthMethodTOTP {
+			linkMethods = append(linkMethods, method)
+		}
+	}
+
+	return &AccountLinkRequiredError{
+		Token:     token,
+		Email:     u.Email,
+		Methods:   linkMethods,
+		ExpiresAt: link.ExpiresAt,
+	}
+}
+
+func getPendingAccountLink(ctx context.Context, token string) (*ent.AccountLink, error) {
+	link, err := database.DB.AccountLink.Query().
+		Where(
+			accountlink.TokenHash(utils.HashToken(token)),
+			accountlink.ExpiresAtGT(time.Now()),
+			accountlink.AttemptsLT(config.GetOTPMaxAttempts()),
+		).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Link request is invalid or has expired, log in with the provider again")
+	}
+	return link, err
+}
+
+// SendAccountLinkCode emails the existing user a code to confirm a pending link with
+func SendAccountLinkCode(ctx context.Context, token string) error {
+	link, err := getPendingAccountLink(ctx, token)
+	if err != nil {
+		return err
+	}
+	u := link.Edges.User
+
+	code, err := GenerateOTP(ctx, u, otp.TypeAccountLink)
+	if err != nil {
+		return err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifications.Send(notifications.NotificationRequest{
+		TemplateID: "otp",
+		Data: &templates.OTPTemplateData{
+			OTP:  code,
+			Name: profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+}
+
+// VerifyAccountLink checks that the user proved they own the account a pending
+// provider identity is waiting to be linked to, and returns the link with its
+// user. The link is only made with CompleteAccountLink, after the second factor
+// for users with two-factor enabled. Login failures are left for the caller to
+// clear once the login completed.
+func VerifyAccountLink(ctx context.Context, token string, creds AccountLinkCredentials, meta SessionMetadata) (*ent.AccountLink, error) {
+	link, err := getPendingAccountLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	u := link.Edges.User
+
+	switch {
+	case creds.Code != "":
+		// Attempts on codes are limited by the OTP itself
+		err = VerifyOTPCode(ctx, u, creds.Code, otp.TypeAccountLink)
+	case creds.Password != "":
+		// Guesses also count against the login throttle of the user's email, so
+		// new links can't be used to get around it
+		err = verifyPassword(ctx, u, creds.Password, meta)
+		if fe, ok := err.(*fiber.Error); ok && fe.Code == fiber.StatusUnauthorized {
+			if burnErr := burnAccountLinkAttempt(ctx, link); burnErr != nil {
+				return nil, burnErr
+			}
+		}
+	default:
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Password or code is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// CompleteAccountLink links the provider identity of a verified link to u
+func CompleteAccountLink(ctx context.Context, u *ent.User, link *ent.AccountLink, meta SessionMetadata) error {
+	// Consume the link first so it can only be completed once
+	deleted, err := database.DB.AccountLink.Delete().
+		Where(
+			accountlink.ID(link.ID),
+			accountlink.ExpiresAtGT(time.Now()),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "Link request is invalid or has expired, log in with the provider again")
+	}
+
+	_, err = linkAccount(ctx, u, account.Type(link.Type.String()), link.ProviderID, meta)
+	return err
+}
+
+// burnAccountLinkAttempt counts a wrong password, dropping the link once
+// config.GetOTPMaxAttempts is reached
+func burnAccountLinkAttempt(ctx context.Context, link *ent.AccountLink) error {
+	if link.Attempts+1 >= config.GetOTPMaxAttempts() {
+		_, err := database.DB.AccountLink.Delete().
+			Where(accountlink.ID(link.ID)).
+			Exec(ctx)
+		return err
+	}
+	return database.DB.AccountLink.UpdateOne(link).
+		AddAttempts(1).
+		Exec(ctx)
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/auditevent"
+	"github.com/NikSchaefer/go-fiber/ent/passkey"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// ListAccounts returns the sign-in methods linked to the user, oldest first.
+// Provider IDs and password hashes are sensitive and never serialized.
+func ListAccounts(ctx context.Context, u *ent.User) ([]*ent.Account, error) {
+	return database.DB.Account.Query().
+		Where(account.HasUserWith(user.IDEQ(u.ID))).
+		Order(ent.Asc(account.FieldCreatedAt)).
+		All(ctx)
+}
+
+// LinkOAuthAccount attaches a provider identity to the user. Unlike
+// HandleOauthLogin the identity's email plays no part, the user is the one
+// who is logged in.
+func LinkOAuthAccount(ctx context.Context, u *ent.User, profile *HandleOauthLoginStruct, meta SessionMetadata) (*ent.Account, error) {
+	err := validator.Validate(profile)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	db := database.DB
+
+	existing, err := db.Account.Query().
+		Where(
+			account.TypeEQ(account.Type(profile.Type)),
+			account.ProviderIDEQ(profile.ProviderID),
+		).
+		WithUser().
+		Only(ctx)
+	if err == nil {
+		if existing.Edges.User != nil && existing.Edges.User.ID == u.ID {
+			return nil, fiber.NewError(fiber.StatusConflict, "This account is already linked")
+		}
+		return nil, fiber.NewError(fiber.StatusConflict, "This account is linked to another user")
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	// One identity per provider keeps unlinking unambiguous
+	linked, err := db.Account.Query().
+		Where(
+			account.TypeEQ(account.Type(profile.Type)),
+			account.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if linked {
+		return nil, fiber.NewError(fiber.StatusConflict, "A different account of this provider is already linked, unlink it first")
+	}
+
+	return linkAccount(ctx, u, account.Type(profile.Type), profile.ProviderID, meta)
+}
+
+// providerNames are how providers are called in notifications
+var providerNames = map[account.Type]string{
+	account.TypePassword:  "a password",
+	account.TypeGoogle:    "Google",
+	account.TypeApple:     "Apple",
+	account.TypeGithub:    "GitHub",
+	account.TypeMicrosoft: "Microsoft",
+	account.TypeGitlab:    "GitLab",
+	account.TypeOidc:      "single sign-on",
+}
+
+// linkAccount attaches a provider identity to the user, records it in the
+// audit trail and lets the user know a new sign-in method was added
+func linkAccount(ctx context.Context, u *ent.User, accountType account.Type, providerID string, meta SessionMetadata) (*ent.Account, error) {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := tx.Account.Create().
+		SetUser(u).
+		SetType(accountType).
+		SetProviderID(providerID).
+		Save(ctx)
+	if err != nil {
+		// Lost a race against another link of the same identity
+		if ent.IsConstraintError(err) {
+			return nil, utils.RollbackTx(tx, fiber.NewError(fiber.StatusConflict, "This account is linked to another user"))
+		}
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	err = RecordAuditEvent(ctx, tx.Client(), u, auditevent.ActionAccountLinked, map[string]string{
+		"type":      accountType.String(),
+		"accountId": a.ID.String(),
+	}, meta)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	analytics.TrackEventWithUser("oauth_account_added", map[string]interface{}{
+		"type": accountType.String(),
+	}, u)
+
+	// The link is in place either way, a lost email shouldn't fail the login
+	if err := notifyAccountLinked(ctx, u, accountType); err != nil {
+		log.Printf("Failed to send account linked email: %v", err)
+	}
+
+	return a, nil
+}
+
+func notifyAccountLinked(ctx context.Context, u *ent.User, accountType account.Type) error {
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifications.Send(notifications.NotificationRequest{
+		TemplateID: "account_linked",
+		Data: &templates.AccountLinkedTemplateData{
+			Provider: providerNames[accountType],
+			Name:     profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+}
+
+// UnlinkAccount removes a sign-in method from the user. The last remaining
+// one, counting passkeys, can't be removed since the user would be locked out.
+func UnlinkAccount(ctx context.Context, u *ent.User, accountID uuid.UUID, meta SessionMetadata) error {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Touching the user row locks it until commit, so concurrent unlinks are
+	// counted one after the other instead of both seeing a spare method
+	err = tx.User.UpdateOneID(u.ID).SetUpdatedAt(time.Now()).Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	a, err := tx.Account.Query().
+		Where(
+			account.ID(accountID),
+			account.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return utils.RollbackTx(tx, fiber.NewError(fiber.StatusNotFound, "Account not found"))
+		}
+		return utils.RollbackTx(tx, err)
+	}
+
+	accounts, err := tx.Account.Query().
+		Where(account.HasUserWith(user.IDEQ(u.ID))).
+		Count(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+	passkeys, err := tx.Passkey.Query().
+		Where(passkey.HasUserWith(user.IDEQ(u.ID))).
+		Count(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+	if accounts+passkeys <= 1 {
+		return utils.RollbackTx(tx, fiber.NewError(fiber.StatusConflict, "Can't remove the last way to log in, add another one first"))
+	}
+
+	err = tx.Account.DeleteOne(a).Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	err = RecordAuditEvent(ctx, tx.Client(), u, auditevent.ActionAccountUnlinked, map[string]string{
+		"type":      a.Type.String(),
+		"accountId": a.ID.String(),
+	}, meta)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	analytics.TrackEventWithUser("account_removed", map[string]interface{}{
+		"type": a.Type.String(),
+	}, u)
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/apikey"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+)
+
+// Scopes an API key can be granted
+const (
+	ScopeUsersRead  = "users:read"
+	ScopeUsersWrite = "users:write"
+)
+
+const (
+	apiKeyPrefix      = "gfk"
+	apiKeySecretBytes = 32
+	// apiKeyLastUsedInterval throttles how often last_used_at is written
+	apiKeyLastUsedInterval = time.Minute
+)
+
+// CreateAPIKey generates a new API key for the user. The returned key is the
+// only copy of the secret and must be shown to the user once.
+func CreateAPIKey(ctx context.Context, u *ent.User, name string, scopes []string, expiresAt *time.Time) (*ent.APIKey, string, error) {
+	prefixBytes := make([]byte, 6)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		return nil, "", err
+	}
+	prefix := hex.EncodeToString(prefixBytes)
+
+	secret, err := utils.GenerateToken(apiKeySecretBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := database.DB.APIKey.Create().
+		SetUser(u).
+		SetName(name).
+		SetPrefix(prefix).
+		SetSecretHash(utils.HashToken(secret)).
+		SetScopes(scopes).
+		SetNillableExpiresAt(expiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, apiKeyPrefix + "_" + prefix + "_" + secret, nil
+}
+
+// ListAPIKeys returns the user's API keys, newest first
+func ListAPIKeys(ctx context.Context, u *ent.User) ([]*ent.APIKey, error) {
+	return database.DB.APIKey.Query().
+		Where(apikey.HasUserWith(user.IDEQ(u.ID))).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+}
+
+// DeleteAPIKey revokes an API key, provided it belongs to the user
+func DeleteAPIKey(ctx context.Context, u *ent.User, id guuid.UUID) error {
+	deleted, err := database.DB.APIKey.Delete().
+		Where(
+			apikey.ID(id),
+			apikey.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusNotFound, "API key not found")
+	}
+	return nil
+}
+
+// ValidateAPIKey checks a raw API key and returns it with the owning user loaded
+func ValidateAPIKey(ctx context.Context, raw string) (*ent.APIKey, error) {
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyPrefix {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid API key")
+	}
+
+	key, err := database.DB.APIKey.Query().
+		Where(apikey.Prefix(parts[1])).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid API key")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(key.SecretHash), []byte(utils.HashToken(parts[2]))) != 1 {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid API key")
+	}
+
+	now := time.Now()
+	if key.ExpiresAt != nil && now.After(*key.ExpiresAt) {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "API key expired")
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyLastUsedInterval {
+		updated, err := key.Update().
+			SetLastUsedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		updated.Edges = key.Edges
+		key = updated
+	}
+
+	return key, nil
+}
+
+// HasScope reports whether the API key was granted the scope
+func HasScope(key *ent.APIKey, scope string) bool {
+	for _, s := range key.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
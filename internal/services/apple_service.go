@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/pkg/jwks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	appleIssuer  = "https://appleid.apple.com"
+	appleAuthURL = "https://appleid.apple.com/auth/authorize"
+	// appleClientSecretTTL is kept short since a fresh secret is signed per exchange
+	appleClientSecretTTL = 5 * time.Minute
+)
+
+var (
+	appleKeysOnce sync.Once
+	appleKeys     *jwks.Cache
+
+	applePrivateKeyOnce sync.Once
+	applePrivateKey     *ecdsa.PrivateKey
+	applePrivateKeyErr  error
+)
+
+// AppleIdentity is what a verified Apple identity token says about the user
+type AppleIdentity struct {
+	Subject string
+	Email   string
+	// PrivateEmail is set for Hide My Email relay addresses, which only forward
+	// mail sent from domains registered with Apple
+	PrivateEmail bool
+}
+
+// AppleName is only sent by Apple on the first authorization of the app
+type AppleName struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+// FullName joins the parts that were shared
+func (n *AppleName) FullName() string {
+	if n == nil {
+		return ""
+	}
+	return strings.TrimSpace(n.FirstName + " " + n.LastName)
+}
+
+// appleIDTokenClaims are the claims Apple signs into identity tokens. Apple
+// has sent the booleans both as JSON booleans and as strings.
+type appleIDTokenClaims struct {
+	jwt.RegisteredClaims
+	Email          string      `json:"email"`
+	EmailVerified  interface{} `json:"email_verified"`
+	IsPrivateEmail interface{} `json:"is_private_email"`
+	Nonce          string      `json:"nonce"`
+}
+
+func claimBool(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+func getAppleKeys() *jwks.Cache {
+	appleKeysOnce.Do(func() {
+		appleKeys = jwks.New(config.GetAppleJWKSURL())
+	})
+	return appleKeys
+}
+
+// appleOAuthConfig returns nil when Sign in with Apple on the web isn't configured
+func appleOAuthConfig() *oauth2.Config {
+	if config.GetAppleClientID() == "" || config.GetAppleRedirectURL() == "" {
+		return nil
+	}
+	return &oauth2.Config{
+		ClientID:    config.GetAppleClientID(),
+		RedirectURL: config.GetAppleRedirectURL(),
+		Scopes:      []string{"name", "email"},
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   appleAuthURL,
+			TokenURL:  config.GetAppleTokenURL(),
+			AuthStyle: oauth2.AuthStyleInParams,
+		},
+	}
+}
+
+// GetAppleAuthURL builds the authorization URL for the web flow. Apple posts
+// the result to the redirect URL as a form since name and email are requested.
+func GetAppleAuthURL(flow *ent.OAuthFlow, state string) (string, error) {
+	conf := appleOAuthConfig()
+	if conf == nil {
+		return "", fiber.NewError(fiber.StatusNotFound, "Sign in with Apple is not configured")
+	}
+	return conf.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("response_mode", "form_post"),
+		oauth2.SetAuthURLParam("nonce", appleNonce(flow.Nonce)),
+	), nil
+}
+
+// AppleConfigured reports whether Sign in with Apple on the web is set up
+func AppleConfigured() bool {
+	return appleOAuthConfig() != nil
+}
+
+// ExchangeAppleCode redeems a web authorization code and verifies the identity
+// token it returns carries the flow's nonce
+func ExchangeAppleCode(ctx context.Context, flow *ent.OAuthFlow, code string) (*AppleIdentity, error) {
+	conf := appleOAuthConfig()
+	if conf == nil {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Sign in with Apple is not configured")
+	}
+
+	secret, err := appleClientSecret(conf.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	conf.ClientSecret = secret
+
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid authorization code")
+	}
+
+	idToken, _ := token.Extra("id_token").(string)
+	if idToken == "" {
+		return nil, errors.New("apple token response has no id_token")
+	}
+
+	return VerifyAppleIDToken(ctx, idToken, []string{conf.ClientID}, flow.Nonce)
+}
+
+// VerifyAppleIDToken checks an identity token's signature against Apple's keys,
+// its issuer, expiry and audience. rawNonce is compared against the token's
+// nonce, which native clients set to the SHA-256 of it, when not empty.
+func VerifyAppleIDToken(ctx context.Context, idToken string, audiences []string, rawNonce string) (*AppleIdentity, error) {
+	claims := &appleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, getAppleKeys().Keyfunc(ctx),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(appleIssuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid Apple identity token")
+	}
+
+	if !audienceAllowed(claims.Audience, audiences) {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Apple identity token was issued for another app")
+	}
+
+	if rawNonce != "" {
+		sum := sha256.Sum256([]byte(rawNonce))
+		if claims.Nonce != hex.EncodeToString(sum[:]) {
+			return nil, fiber.NewError(fiber.StatusUnauthorized, "Apple identity token nonce does not match")
+		}
+	}
+
+	if claims.Subject == "" {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid Apple identity token")
+	}
+	if claims.Email != "" && !claimBool(claims.EmailVerified) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Apple email not verified")
+	}
+
+	return &AppleIdentity{
+		Subject:      claims.Subject,
+		Email:        strings.ToLower(claims.Email),
+		PrivateEmail: claimBool(claims.IsPrivateEmail),
+	}, nil
+}
+
+func audienceAllowed(audience jwt.ClaimStrings, allowed []string) bool {
+	for _, aud := range audience {
+		for _, a := range allowed {
+			if a != "" && aud == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ParseAppleUser decodes the user JSON Apple posts with the first web authorization
+func ParseAppleUser(raw string) *AppleName {
+	if raw == "" {
+		return nil
+	}
+	var user struct {
+		Name AppleName `json:"name"`
+	}
+	if err := json.Unmarshal([]byte(raw), &user); err != nil {
+		return nil
+	}
+	return &user.Name
+}
+
+// appleClientSecret signs the ES256 JWT Apple accepts in place of a static client secret
+func appleClientSecret(clientID string) (string, error) {
+	key, err := loadApplePrivateKey()
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+		Issuer:    config.GetAppleTeamID(),
+		Subject:   clientID,
+		Audience:  jwt.ClaimStrings{appleIssuer},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(appleClientSecretTTL)),
+	})
+	token.Header["kid"] = config.GetAppleKeyID()
+
+	return token.SignedString(key)
+}
+
+func loadApplePrivateKey() (*ecdsa.PrivateKey, error) {
+	applePrivateKeyOnce.Do(func() {
+		path := config.GetApplePrivateKeyPath()
+		if path == "" || config.GetAppleTeamID() == "" || config.GetAppleKeyID() == "" {
+			applePrivateKeyErr = errors.New("APPLE_PRIVATE_KEY_PATH, APPLE_TEAM_ID and APPLE_KEY_ID must be set")
+			return
+		}
+
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			applePrivateKeyErr = err
+			return
+		}
+		block, _ := pem.Decode(raw)
+		if block == nil {
+			applePrivateKeyErr = errors.New("apple private key: no PEM block found")
+			return
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			applePrivateKeyErr = err
+			return
+		}
+		ecKey, ok := key.(*ecdsa.PrivateKey)
+		if !ok {
+			applePrivateKeyErr = errors.New("apple private key must be an EC key")
+			return
+		}
+		applePrivateKey = ecKey
+	})
+	return applePrivateKey, applePrivateKeyErr
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testAppleAudience = "com.example.app"
+
+// useAppleStub points Apple key lookups at a fresh stub through APPLE_JWKS_URL
+func useAppleStub(t *testing.T) *jwksStub {
+	t.Helper()
+
+	stub := newJWKSStub(t, "apple-1")
+	t.Setenv("APPLE_JWKS_URL", stub.URL)
+	appleKeysOnce = sync.Once{}
+	return stub
+}
+
+func appleClaims(overrides jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":              appleIssuer,
+		"aud":              testAppleAudience,
+		"sub":              "001234.abcdef",
+		"exp":              time.Now().Add(10 * time.Minute).Unix(),
+		"iat":              time.Now().Unix(),
+		"email":            "Jane@PrivateRelay.AppleID.com",
+		"email_verified":   "true",
+		"is_private_email": "true",
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func hashedNonce(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func expectStatus(t *testing.T, err error, status int) {
+	t.Helper()
+
+	fe, ok := err.(*fiber.Error)
+	if !ok {
+		t.Fatalf("err = %v, want a %d fiber error", err, status)
+	}
+	if fe.Code != status {
+		t.Fatalf("status = %d (%s), want %d", fe.Code, fe.Message, status)
+	}
+}
+
+func TestVerifyAppleIDToken(t *testing.T) {
+	stub := useAppleStub(t)
+
+	token := stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"nonce": hashedNonce("raw-nonce")}))
+	identity, err := VerifyAppleIDToken(context.Background(), token, []string{"com.example.web", testAppleAudience}, "raw-nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if identity.Subject != "001234.abcdef" {
+		t.Errorf("subject = %q", identity.Subject)
+	}
+	if identity.Email != "jane@privaterelay.appleid.com" {
+		t.Errorf("email = %q, want it lowercased", identity.Email)
+	}
+	if !identity.PrivateEmail {
+		t.Error("relay address not reported as private")
+	}
+}
+
+func TestVerifyAppleIDTokenAcceptsBooleanClaims(t *testing.T) {
+	stub := useAppleStub(t)
+
+	token := stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{
+		"email_verified":   true,
+		"is_private_email": false,
+	}))
+	identity, err := VerifyAppleIDToken(context.Background(), token, []string{testAppleAudience}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if identity.PrivateEmail {
+		t.Error("private email reported for is_private_email false")
+	}
+}
+
+func TestVerifyAppleIDTokenRejects(t *testing.T) {
+	stub := useAppleStub(t)
+	otherKey := generateRSAKey(t)
+
+	tests := []struct {
+		name   string
+		token  string
+		nonce  string
+		status int
+	}{
+		{
+			name:   "bad signature",
+			token:  signWith(t, otherKey, "apple-1", appleClaims(nil)),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "wrong audience",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"aud": "com.attacker.app"})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "wrong issuer",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"iss": "https://appleid.example.com"})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "expired",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "no expiry",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"exp": nil})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "nonce mismatch",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"nonce": hashedNonce("other-nonce")})),
+			nonce:  "raw-nonce",
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "raw nonce instead of its hash",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"nonce": "raw-nonce"})),
+			nonce:  "raw-nonce",
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "missing nonce",
+			token:  stub.sign(t, "apple-1", appleClaims(nil)),
+			nonce:  "raw-nonce",
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "unverified email",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"email_verified": "false"})),
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name:   "unverified email as boolean",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"email_verified": false})),
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name:   "no subject",
+			token:  stub.sign(t, "apple-1", appleClaims(jwt.MapClaims{"sub": nil})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "unknown key",
+			token:  signWith(t, otherKey, "apple-2", appleClaims(nil)),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name: "HS256 signed with the public key",
+			token: func() string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, appleClaims(nil))
+				token.Header["kid"] = "apple-1"
+				signed, err := token.SignedString(stub.keys["apple-1"].PublicKey.N.Bytes())
+				if err != nil {
+					t.Fatal(err)
+				}
+				return signed
+			}(),
+			status: fiber.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyAppleIDToken(context.Background(), tt.token, []string{testAppleAudience}, tt.nonce)
+			expectStatus(t, err, tt.status)
+		})
+	}
+}
+
+func TestVerifyAppleIDTokenFetchesKeyForUnknownKid(t *testing.T) {
+	stub := useAppleStub(t)
+
+	// The key set is fetched lazily, so the first token's kid is unknown to the
+	// cache and has to be looked up at the stub
+	rotated := generateRSAKey(t)
+	stub.mu.Lock()
+	stub.keys["apple-2"] = rotated
+	stub.mu.Unlock()
+
+	token := signWith(t, rotated, "apple-2", appleClaims(nil))
+	if _, err := VerifyAppleIDToken(context.Background(), token, []string{testAppleAudience}, ""); err != nil {
+		t.Fatalf("token signed with a newly published key: %v", err)
+	}
+}
@@ -0,0 +1,20 @@
+package services
+
+import (
+	"context"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/auditevent"
+)
+
+// RecordAuditEvent adds an entry to the user's audit trail. Pass a
+// transaction's client to commit the entry together with the change it records.
+func RecordAuditEvent(ctx context.Context, client *ent.Client, u *ent.User, action auditevent.Action, details map[string]string, meta SessionMetadata) error {
+	return client.AuditEvent.Create().
+		SetUser(u).
+		SetAction(action).
+		SetDetails(details).
+		SetIPAddress(meta.IPAddress).
+		SetUserAgent(truncate(meta.UserAgent, 512)).
+		Exec(ctx)
+}
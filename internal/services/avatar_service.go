@@ -0,0 +1,122 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/pkg/imaging"
+	"github.com/NikSchaefer/go-fiber/pkg/storage"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// maxAvatarPixels bounds the decoded bitmap, about 100MB at 4 bytes per pixel
+const maxAvatarPixels = 24_000_000
+
+// SetAvatar resizes an uploaded image into the config.GetAvatarSizes squares,
+// stores them and points the profile at them. The upload itself is never
+// stored, only JPEGs re-encoded from its pixels, which drops EXIF data.
+func SetAvatar(ctx context.Context, u *ent.User, data []byte) (*ent.Profile, error) {
+	if len(data) > config.GetAvatarMaxBytes() {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, fmt.Sprintf("Avatar must be at most %d bytes", config.GetAvatarMaxBytes()))
+	}
+
+	img, err := imaging.Decode(data, maxAvatarPixels)
+	if err == imaging.ErrUnsupportedFormat {
+		return nil, fiber.NewError(fiber.StatusUnsupportedMediaType, err.Error())
+	}
+	if err == imaging.ErrTooManyPixels {
+		return nil, fiber.NewError(fiber.StatusRequestEntityTooLarge, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	orientation := imaging.Orientation(data)
+
+	// Every upload gets new keys, so cached copies of the old avatar never show
+	version, err := utils.GenerateToken(8)
+	if err != nil {
+		return nil, err
+	}
+	key := "avatars/" + u.ID.String() + "/" + version
+
+	sizes := config.GetAvatarSizes()
+	urls := make(map[string]string, len(sizes))
+	for _, size := range sizes {
+		thumbnail, err := imaging.EncodeJPEG(imaging.SquareThumbnail(img, size, orientation))
+		if err != nil {
+			return nil, err
+		}
+
+		sizeKey := avatarSizeKey(key, strconv.Itoa(size))
+		err = storage.Put(ctx, sizeKey, bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg")
+		if err != nil {
+			deleteAvatarFiles(ctx, key, urls)
+			return nil, err
+		}
+		urls[strconv.Itoa(size)] = storage.URL(sizeKey)
+	}
+
+	pro, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		deleteAvatarFiles(ctx, key, urls)
+		return nil, err
+	}
+
+	updated, err := pro.Update().
+		SetAvatarURL(urls[strconv.Itoa(sizes[len(sizes)/2])]).
+		SetAvatarSizes(urls).
+		SetAvatarKey(key).
+		Save(ctx)
+	if err != nil {
+		deleteAvatarFiles(ctx, key, urls)
+		return nil, err
+	}
+
+	deleteAvatarFiles(ctx, pro.AvatarKey, pro.AvatarSizes)
+
+	return updated, nil
+}
+
+// RemoveAvatar clears the profile's avatar, deleting it from storage if it was uploaded
+func RemoveAvatar(ctx context.Context, u *ent.User) (*ent.Profile, error) {
+	pro, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := pro.Update().
+		ClearAvatarURL().
+		ClearAvatarSizes().
+		ClearAvatarKey().
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteAvatarFiles(ctx, pro.AvatarKey, pro.AvatarSizes)
+
+	return updated, nil
+}
+
+func avatarSizeKey(key, size string) string {
+	return key + "/" + size + ".jpg"
+}
+
+// deleteAvatarFiles removes an uploaded avatar's sizes. Failures only leave
+// unreferenced files behind, so they are logged instead of failing the request.
+func deleteAvatarFiles(ctx context.Context, key string, sizes map[string]string) {
+	if key == "" {
+		return
+	}
+	for size := range sizes {
+		if err := storage.Delete(ctx, avatarSizeKey(key, size)); err != nil {
+			log.Printf("Failed to delete avatar %s: %v", avatarSizeKey(key, size), err)
+		}
+	}
+}
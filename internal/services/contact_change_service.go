@@ -0,0 +1,396 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/contactchange"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/tokenfamily"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+)
+
+// revertTokenBytes is the entropy of the revert link sent to the old email address
+const revertTokenBytes = 32
+
+// RequestPhoneChange texts a verification code to the new number. The user's
+// current number stays in place until ConfirmPhoneChange, and an earlier pending
+// change is replaced.
+func RequestPhoneChange(ctx context.Context, u *ent.User, phone string) (*ent.ContactChange, error) {
+	if err := validator.ValidatePhoneUniqueness(ctx, database.DB, phone); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ContactChange.Delete().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypePhone),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	change, err := tx.ContactChange.Create().
+		SetUser(u).
+		SetType(contactchange.TypePhone).
+		SetValue(phone).
+		SetExpiresAt(time.Now().Add(config.GetOTPTTL(string(otp.TypePhoneVerification)))).
+		Save(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	code, err := GenerateOTP(ctx, u, otp.TypePhoneVerification)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = notifications.Send(notifications.NotificationRequest{
+		TemplateID: "otp",
+		Data: &templates.OTPTemplateData{
+			OTP:  code,
+			Name: profile.Name,
+		},
+		PhoneNumber: &phone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// ConfirmPhoneChange applies the pending phone change if the code matches,
+// marking the new number as verified
+func ConfirmPhoneChange(ctx context.Context, u *ent.User, code string) (*ent.User, error) {
+	change, err := database.DB.ContactChange.Query().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypePhone),
+			contactchange.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(contactchange.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "No pending phone number change")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyOTPCode(ctx, u, code, otp.TypePhoneVerification); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		SetPhoneNumber(change.Value).
+		SetPhoneNumberVerified(true).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Someone else confirmed the number since the change was requested
+		_ = tx.Rollback()
+		return nil, fiber.NewError(fiber.StatusConflict, "phone number already exists")
+	}
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	err = tx.ContactChange.DeleteOne(change).Exec(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RemovePhoneNumber clears the user's number along with any pending change
+func RemovePhoneNumber(ctx context.Context, u *ent.User) (*ent.User, error) {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ContactChange.Delete().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypePhone),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		ClearPhoneNumber().
+		SetPhoneNumberVerified(false).
+		Save(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RequestEmailChange sends a confirmation code to the new address and a notice
+// with a revert link to the current one. The email is only swapped by ConfirmEmailChange.
+func RequestEmailChange(ctx context.Context, u *ent.User, email string) (*ent.ContactChange, error) {
+	email = strings.ToLower(email)
+	if email == u.Email {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "New email is the same as the current one")
+	}
+	if err := validator.ValidateEmailUniqueness(ctx, database.DB, email); err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	}
+
+	revertToken, err := utils.GenerateToken(revertTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirmed changes are kept for their revert links, only replace unconfirmed ones
+	_, err = tx.ContactChange.Delete().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypeEmail),
+			contactchange.ConfirmedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	now := time.Now()
+	change, err := tx.ContactChange.Create().
+		SetUser(u).
+		SetType(contactchange.TypeEmail).
+		SetValue(email).
+		SetPreviousValue(u.Email).
+		SetExpiresAt(now.Add(config.GetOTPTTL(string(otp.TypeEmailChange)))).
+		SetRevertTokenHash(utils.HashToken(revertToken)).
+		SetRevertExpiresAt(now.Add(config.GetEmailChangeRevertTTL())).
+		Save(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	code, err := GenerateOTP(ctx, u, otp.TypeEmailChange)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = notifications.Send(notifications.NotificationRequest{
+		TemplateID: "otp",
+		Data: &templates.OTPTemplateData{
+			OTP:  code,
+			Name: profile.Name,
+		},
+		EmailAddress: &email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = notifications.Send(notifications.NotificationRequest{
+		TemplateID: "email_change",
+		Data: &templates.EmailChangeTemplateData{
+			RevertToken: revertToken,
+			NewEmail:    email,
+			Name:        profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// ConfirmEmailChange swaps in the new address if the code matches, then revokes
+// every session and token family other than the one making the request
+func ConfirmEmailChange(ctx context.Context, u *ent.User, code string, s *ent.Session, claims *tokens.AccessClaims) (*ent.User, error) {
+	change, err := database.DB.ContactChange.Query().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypeEmail),
+			contactchange.ConfirmedAtIsNil(),
+			contactchange.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(contactchange.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "No pending email change")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyOTPCode(ctx, u, code, otp.TypeEmailChange); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The address may have been taken since the change was requested
+	if err := validator.ValidateEmailUniqueness(ctx, tx.Client(), change.Value); err != nil {
+		return nil, utils.RollbackTx(tx, fiber.NewError(fiber.StatusConflict, err.Error()))
+	}
+
+	updated, err := tx.User.UpdateOne(u).
+		SetEmail(change.Value).
+		SetEmailVerified(true).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, utils.RollbackTx(tx, fiber.NewError(fiber.StatusConflict, "email already exists"))
+	}
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	err = tx.ContactChange.UpdateOne(change).
+		SetConfirmedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	currentSessionID, currentFamilyID := guuid.Nil, guuid.Nil
+	if s != nil {
+		currentSessionID = s.ID
+	}
+	if claims != nil {
+		currentFamilyID, _ = guuid.Parse(claims.SessionID)
+	}
+
+	if _, err := RevokeOtherSessions(ctx, updated, currentSessionID); err != nil {
+		return nil, err
+	}
+	if err := RevokeOtherTokenFamilies(ctx, updated, currentFamilyID, tokenfamily.RevokeReasonAccountChange); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RevertEmailChange handles the link sent to the old address. A pending change
+// is cancelled; a confirmed one is undone and every session and token family is
+// revoked, since whoever confirmed it may have taken over the account.
+func RevertEmailChange(ctx context.Context, token string) error {
+	change, err := database.DB.ContactChange.Query().
+		Where(
+			contactchange.RevertTokenHash(utils.HashToken(token)),
+			contactchange.RevertExpiresAtGT(time.Now()),
+		).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid or expired revert token")
+	}
+	if err != nil {
+		return err
+	}
+	u := change.Edges.User
+
+	if change.ConfirmedAt == nil {
+		return database.DB.ContactChange.DeleteOne(change).Exec(ctx)
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if u.Email != change.PreviousValue {
+		if err := validator.ValidateEmailUniqueness(ctx, tx.Client(), change.PreviousValue); err != nil {
+			return utils.RollbackTx(tx, fiber.NewError(fiber.StatusConflict, err.Error()))
+		}
+	}
+
+	u, err = tx.User.UpdateOne(u).
+		SetEmail(change.PreviousValue).
+		SetEmailVerified(true).
+		Save(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	// Later email changes were made by the same person, drop them along with this one
+	_, err = tx.ContactChange.Delete().
+		Where(
+			contactchange.HasUserWith(user.IDEQ(u.ID)),
+			contactchange.TypeEQ(contactchange.TypeEmail),
+		).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	if _, err := RevokeOtherSessions(ctx, u, guuid.Nil); err != nil {
+		return err
+	}
+	return RevokeOtherTokenFamilies(ctx, u, guuid.Nil, tokenfamily.RevokeReasonAccountChange)
+}
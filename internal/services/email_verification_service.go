@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/gofiber/fiber/v2"
+)
+
+// SendEmailVerification emails the user a code to verify their address. It
+// returns how long to wait instead when the last code was sent within the cooldown.
+func SendEmailVerification(ctx context.Context, u *ent.User) (time.Duration, error) {
+	if u.EmailVerified {
+		return 0, fiber.NewError(fiber.StatusConflict, "Email is already verified")
+	}
+
+	last, err := database.DB.OTP.Query().
+		Where(
+			otp.HasUserWith(user.IDEQ(u.ID)),
+			otp.TypeEQ(otp.TypeEmailVerification),
+		).
+		Order(ent.Desc(otp.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return 0, err
+	}
+	if last != nil {
+		if wait := time.Until(last.CreatedAt.Add(config.GetEmailVerificationResendCooldown())); wait > 0 {
+			return wait, nil
+		}
+	}
+
+	code, err := GenerateOTP(ctx, u, otp.TypeEmailVerification)
+	if err != nil {
+		return 0, err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return 0, notifications.Send(notifications.NotificationRequest{
+		TemplateID: "otp",
+		Data: &templates.OTPTemplateData{
+			OTP:  code,
+			Name: profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+}
+
+// VerifyEmail marks the user's address as verified if the code matches
+func VerifyEmail(ctx context.Context, u *ent.User, code string) (*ent.User, error) {
+	if u.EmailVerified {
+		return u, nil
+	}
+
+	if err := VerifyOTPCode(ctx, u, code, otp.TypeEmailVerification); err != nil {
+		return nil, err
+	}
+
+	return database.DB.User.UpdateOne(u).
+		SetEmailVerified(true).
+		Save(ctx)
+}
+
+func markEmailVerified(ctx context.Context, u *ent.User) error {
+	if u.EmailVerified {
+		return nil
+	}
+	return database.DB.User.UpdateOne(u).
+		SetEmailVerified(true).
+		Exec(ctx)
+}
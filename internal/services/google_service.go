@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/pkg/jwks"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	googleKeysOnce sync.Once
+	googleKeys     *jwks.Cache
+)
+
+// googleIDTokenClaims are the claims Google signs into ID tokens
+type googleIDTokenClaims struct {
+	jwt.RegisteredClaims
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"`
+	Name          string      `json:"name"`
+	Picture       string      `json:"picture"`
+}
+
+func getGoogleKeys() *jwks.Cache {
+	googleKeysOnce.Do(func() {
+		googleKeys = jwks.New(config.GetGoogleJWKSURL())
+	})
+	return googleKeys
+}
+
+// VerifyGoogleIDToken checks an ID token's signature against Google's keys,
+// its issuer, expiry and that it was issued for one of config.GetGoogleClientIDs.
+// Only tokens for a verified email are accepted.
+func VerifyGoogleIDToken(ctx context.Context, idToken string) (*HandleOauthLoginStruct, error) {
+	audiences := config.GetGoogleClientIDs()
+	if len(audiences) == 0 {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Google login is not configured")
+	}
+
+	claims := &googleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, getGoogleKeys().Keyfunc(ctx),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid Google ID token")
+	}
+
+	// Google issues tokens with and without the scheme
+	if claims.Issuer != "accounts.google.com" && claims.Issuer != "https://accounts.google.com" {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid Google ID token")
+	}
+
+	if !audienceAllowed(claims.Audience, audiences) {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Google ID token was issued for another app")
+	}
+
+	if claims.Subject == "" || claims.Email == "" {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid Google ID token")
+	}
+	if !claimBool(claims.EmailVerified) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Google email not verified")
+	}
+
+	// The name is only included when the profile scope was requested
+	name := claims.Name
+	if len(name) < 2 {
+		name = strings.Split(claims.Email, "@")[0]
+	}
+
+	profile := &HandleOauthLoginStruct{
+		Email:         strings.ToLower(claims.Email),
+		EmailVerified: true,
+		Name:          name,
+		Type:          "google",
+		ProviderID:    claims.Subject,
+	}
+	if claims.Picture != "" && len(claims.Picture) <= 2048 {
+		profile.AvatarURL = &claims.Picture
+	}
+
+	return profile, nil
+}
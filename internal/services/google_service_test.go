@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// useGoogleStub points Google key lookups at a fresh stub and allows a web and
+// an iOS client ID
+func useGoogleStub(t *testing.T) *jwksStub {
+	t.Helper()
+
+	stub := newJWKSStub(t, "google-1")
+	t.Setenv("GOOGLE_JWKS_URL", stub.URL)
+	t.Setenv("GOOGLE_CLIENT_IDS", "web.apps.googleusercontent.com, ios.apps.googleusercontent.com")
+	googleKeysOnce = sync.Once{}
+	return stub
+}
+
+func googleClaims(overrides jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"iss":            "https://accounts.google.com",
+		"aud":            "web.apps.googleusercontent.com",
+		"sub":            "1098765432",
+		"exp":            time.Now().Add(10 * time.Minute).Unix(),
+		"iat":            time.Now().Unix(),
+		"email":          "Jane@Example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+	}
+	for k, v := range overrides {
+		if v == nil {
+			delete(claims, k)
+			continue
+		}
+		claims[k] = v
+	}
+	return claims
+}
+
+func TestVerifyGoogleIDTokenAccepts(t *testing.T) {
+	stub := useGoogleStub(t)
+
+	tests := []struct {
+		name      string
+		overrides jwt.MapClaims
+	}{
+		{name: "issuer with scheme"},
+		{name: "issuer without scheme", overrides: jwt.MapClaims{"iss": "accounts.google.com"}},
+		{name: "second client ID", overrides: jwt.MapClaims{"aud": "ios.apps.googleusercontent.com"}},
+		{name: "email_verified as string", overrides: jwt.MapClaims{"email_verified": "true"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := stub.sign(t, "google-1", googleClaims(tt.overrides))
+			profile, err := VerifyGoogleIDToken(context.Background(), token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if profile.ProviderID != "1098765432" || profile.Type != "google" {
+				t.Errorf("profile = %+v", profile)
+			}
+			if profile.Email != "jane@example.com" {
+				t.Errorf("email = %q, want it lowercased", profile.Email)
+			}
+		})
+	}
+}
+
+func TestVerifyGoogleIDTokenRejects(t *testing.T) {
+	stub := useGoogleStub(t)
+
+	tests := []struct {
+		name   string
+		token  string
+		status int
+	}{
+		{
+			name:   "audience outside GOOGLE_CLIENT_IDS",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"aud": "attacker.apps.googleusercontent.com"})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "wrong issuer",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"iss": "https://accounts.google.com.example.com"})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "expired",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()})),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "bad signature",
+			token:  signWith(t, generateRSAKey(t), "google-1", googleClaims(nil)),
+			status: fiber.StatusUnauthorized,
+		},
+		{
+			name:   "email_verified false as string",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"email_verified": "false"})),
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name:   "email_verified false as boolean",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"email_verified": false})),
+			status: fiber.StatusBadRequest,
+		},
+		{
+			name:   "email_verified missing",
+			token:  stub.sign(t, "google-1", googleClaims(jwt.MapClaims{"email_verified": nil})),
+			status: fiber.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := VerifyGoogleIDToken(context.Background(), tt.token)
+			expectStatus(t, err, tt.status)
+		})
+	}
+}
+
+func TestVerifyGoogleIDTokenNotConfigured(t *testing.T) {
+	stub := useGoogleStub(t)
+	t.Setenv("GOOGLE_CLIENT_IDS", "")
+	t.Setenv("GOOGLE_CLIENT_ID", "")
+
+	_, err := VerifyGoogleIDToken(context.Background(), stub.sign(t, "google-1", googleClaims(nil)))
+	expectStatus(t, err, fiber.StatusNotFound)
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/loginthrottle"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	unlockTokenBytes = 32
+	// throttleRetries bounds the optimistic update loop when failures race
+	throttleRetries = 5
+)
+
+// throttlePolicy decides how long a key is blocked after a number of consecutive failures
+type throttlePolicy struct {
+	backoffAfter int
+	lockoutAfter int
+}
+
+func accountThrottleKey(identifier string) string {
+	return "account:" + identifier
+}
+
+func ipThrottleKey(ip string) string {
+	return "ip:" + ip
+}
+
+func secondFactorThrottleKey(u *ent.User) string {
+	return "mfa:" + u.ID.String()
+}
+
+func accountThrottlePolicy() throttlePolicy {
+	return throttlePolicy{
+		backoffAfter: config.GetLoginAccountBackoffAfter(),
+		lockoutAfter: config.GetLoginAccountLockoutAfter(),
+	}
+}
+
+func ipThrottlePolicy() throttlePolicy {
+	return throttlePolicy{
+		backoffAfter: config.GetLoginIPBackoffAfter(),
+		lockoutAfter: config.GetLoginIPLockoutAfter(),
+	}
+}
+
+// blockedUntil returns when the next attempt is allowed, or nil if it is allowed
+// right away. Delays double with every failure past the backoff threshold and
+// become a full lockout at the lockout threshold.
+func (p throttlePolicy) blockedUntil(now time.Time, failures int) *time.Time {
+	lockout := config.GetLoginLockoutDuration()
+
+	var delay time.Duration
+	switch {
+	case failures >= p.lockoutAfter:
+		delay = lockout
+	case failures >= p.backoffAfter:
+		delay = config.GetLoginBackoffBase()
+		for i := p.backoffAfter; i < failures && delay < lockout; i++ {
+			delay *= 2
+		}
+		if delay > lockout {
+			delay = lockout
+		}
+	default:
+		return nil
+	}
+
+	until := now.Add(delay)
+	return &until
+}
+
+// CheckLoginAllowed returns how long the client has to wait before another
+// login attempt for the identifier from the IP, zero if it may try now
+func CheckLoginAllowed(ctx context.Context, identifier string, ip string) (time.Duration, error) {
+	throttles, err := database.DB.LoginThrottle.Query().
+		Where(loginthrottle.KeyIn(accountThrottleKey(identifier), ipThrottleKey(ip))).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var wait time.Duration
+	for _, t := range throttles {
+		if t.LockedUntil == nil {
+			continue
+		}
+		if remaining := time.Until(*t.LockedUntil); remaining > wait {
+			wait = remaining
+		}
+	}
+
+	return wait, nil
+}
+
+// RecordLoginFailure counts a failed login against both the identifier and the IP.
+// When the account crosses the lockout threshold, u (if the account exists) is
+// emailed a link to unlock it early.
+func RecordLoginFailure(ctx context.Context, identifier string, ip string, u *ent.User) error {
+	account, locked, err := recordThrottleFailure(ctx, accountThrottleKey(identifier), accountThrottlePolicy())
+	if err != nil {
+		return err
+	}
+
+	if _, _, err := recordThrottleFailure(ctx, ipThrottleKey(ip), ipThrottlePolicy()); err != nil {
+		return err
+	}
+
+	if locked {
+		log.Printf("security: login locked for %s after %d failed attempts", identifier, account.Failures)
+		if u != nil {
+			if err := sendUnlockEmail(ctx, account, u); err != nil {
+				log.Printf("Failed to send unlock email: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordThrottleFailure increments the failure count of a key and reports whether
+// this failure is the one that locked it. Concurrent failures are resolved with
+// an optimistic compare on the previous count.
+func recordThrottleFailure(ctx context.Context, key string, policy throttlePolicy) (*ent.LoginThrottle, bool, error) {
+	db := database.DB
+
+	for i := 0; i < throttleRetries; i++ {
+		now := time.Now()
+
+		t, err := db.LoginThrottle.Query().
+			Where(loginthrottle.Key(key)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			t, err = db.LoginThrottle.Create().
+				SetKey(key).
+				SetFailures(1).
+				SetLastFailureAt(now).
+				SetNillableLockedUntil(policy.blockedUntil(now, 1)).
+				Save(ctx)
+			if ent.IsConstraintError(err) {
+				// Someone else created it first, count against their row
+				continue
+			}
+			if err != nil {
+				return nil, false, err
+			}
+			return t, 1 == policy.lockoutAfter, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		failures := t.Failures + 1
+		if now.Sub(t.LastFailureAt) > config.GetLoginFailureWindow() {
+			failures = 1
+		}
+
+		update := db.LoginThrottle.Update().
+			Where(
+				loginthrottle.ID(t.ID),
+				loginthrottle.Failures(t.Failures),
+			).
+			SetFailures(failures).
+			SetLastFailureAt(now)
+		if until := policy.blockedUntil(now, failures); until != nil {
+			update.SetLockedUntil(*until)
+		} else {
+			update.ClearLockedUntil()
+		}
+
+		updated, err := update.Save(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		if updated == 0 {
+			continue
+		}
+
+		t.Failures = failures
+		return t, failures == policy.lockoutAfter, nil
+	}
+
+	return nil, false, fiber.NewError(fiber.StatusTooManyRequests, "Too many login attempts, try again later")
+}
+
+// sendUnlockEmail stores a fresh unlock token for the throttle and emails it to the user
+func sendUnlockEmail(ctx context.Context, t *ent.LoginThrottle, u *ent.User) error {
+	token, err := utils.GenerateToken(unlockTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	err = database.DB.LoginThrottle.UpdateOne(t).
+		SetUnlockTokenHash(utils.HashToken(token)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	return notifications.Send(notifications.NotificationRequest{
+		TemplateID: "account_locked",
+		Data: &templates.AccountLockedTemplateData{
+			UnlockToken: token,
+			Name:        profile.Name,
+		},
+		EmailAddress: &u.Email,
+	})
+}
+
+// ResetLoginFailures clears the account's failure count after a successful login
+func ResetLoginFailures(ctx context.Context, identifier string) error {
+	_, err := database.DB.LoginThrottle.Delete().
+		Where(loginthrottle.Key(accountThrottleKey(identifier))).
+		Exec(ctx)
+	return err
+}
+
+// ResetUserLoginFailures clears the failure counts of every identifier the user
+// logs in with, once a login that needed a second factor fully succeeded
+func ResetUserLoginFailures(ctx context.Context, u *ent.User) error {
+	keys := []string{accountThrottleKey(strings.ToLower(u.Email))}
+	if u.PhoneNumber != "" {
+		keys = append(keys, accountThrottleKey(u.PhoneNumber))
+	}
+	_, err := database.DB.LoginThrottle.Delete().
+		Where(loginthrottle.KeyIn(keys...)).
+		Exec(ctx)
+	return err
+}
+
+// beginSecondFactorAttempt counts a second factor attempt against the user
+// before the code is checked, so neither new challenges nor concurrent guesses
+// get around the limit. A correct code clears the count again with
+// resetSecondFactorFailures.
+func beginSecondFactorAttempt(ctx context.Context, u *ent.User) error {
+	key := secondFactorThrottleKey(u)
+	policy := accountThrottlePolicy()
+
+	t, err := database.DB.LoginThrottle.Query().
+		Where(loginthrottle.Key(key)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+	if t != nil && t.LockedUntil != nil && time.Now().Before(*t.LockedUntil) {
+		return fiber.NewError(fiber.StatusTooManyRequests, "Too many invalid codes, try again later")
+	}
+
+	t, locked, err := recordThrottleFailure(ctx, key, policy)
+	if err != nil {
+		return err
+	}
+	if locked {
+		log.Printf("security: second factor locked for user %s after %d invalid codes", u.ID, t.Failures)
+	}
+	if t.Failures > policy.lockoutAfter {
+		return fiber.NewError(fiber.StatusTooManyRequests, "Too many invalid codes, try again later")
+	}
+	return nil
+}
+
+func resetSecondFactorFailures(ctx context.Context, u *ent.User) error {
+	_, err := database.DB.LoginThrottle.Delete().
+		Where(loginthrottle.Key(secondFactorThrottleKey(u))).
+		Exec(ctx)
+	return err
+}
+
+// UnlockLogin lifts an account lockout using the token from the unlock email
+func UnlockLogin(ctx context.Context, token string) error {
+	deleted, err := database.DB.LoginThrottle.Delete().
+		Where(loginthrottle.UnlockTokenHash(utils.HashToken(token))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid or expired unlock token")
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/magiclink"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications/templates"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/mssola/useragent"
+)
+
+const magicLinkTokenBytes = 32
+
+// MagicLinkOptions are chosen by the device requesting the link
+type MagicLinkOptions struct {
+	// RedirectTo must be on one of the ALLOWED_ORIGINS, see ValidateRedirectURL
+	RedirectTo string
+	SameDevice bool
+}
+
+// SendMagicLink emails the user a login link and returns the poll token for the
+// requesting device. Links sent earlier that haven't been used are invalidated.
+func SendMagicLink(ctx context.Context, u *ent.User, opts MagicLinkOptions, meta SessionMetadata) (string, *ent.MagicLink, error) {
+	if opts.RedirectTo != "" {
+		if err := ValidateRedirectURL(opts.RedirectTo); err != nil {
+			return "", nil, err
+		}
+	}
+
+	token, err := utils.GenerateToken(magicLinkTokenBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	pollToken, err := utils.GenerateToken(magicLinkTokenBytes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, err = tx.MagicLink.Delete().
+		Where(
+			magiclink.HasUserWith(user.IDEQ(u.ID)),
+			magiclink.StatusEQ(magiclink.StatusPending),
+		).
+		Exec(ctx)
+	if err != nil {
+		return "", nil, utils.RollbackTx(tx, err)
+	}
+
+	link, err := tx.MagicLink.Create().
+		SetUser(u).
+		SetTokenHash(utils.HashToken(token)).
+		SetPollTokenHash(utils.HashToken(pollToken)).
+		SetRedirectTo(opts.RedirectTo).
+		SetSameDevice(opts.SameDevice).
+		Save(ctx)
+	if err != nil {
+		return "", nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", nil, err
+	}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Show where the link was requested from, so a user who didn't ask for it
+	// doesn't approve a login for someone else's device
+	requestedFrom := meta.IPAddress
+	if meta.UserAgent != "" {
+		ua := useragent.New(meta.UserAgent)
+		browser, _ := ua.Browser()
+		requestedFrom = browser + " on " + ua.OSInfo().Name + " (" + meta.IPAddress + ")"
+	}
+
+	err = notifications.Send(notifications.NotificationRequest{
+		TemplateID: "magic_link",
+		Data: &templates.MagicLinkTemplateData{
+			Token:         token,
+			Name:          profile.Name,
+			RequestedFrom: requestedFrom,
+			ValidFor:      config.GetMagicLinkTTL(),
+		},
+		EmailAddress: &u.Email,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return pollToken, link, nil
+}
+
+// DecoyMagicLink answers a link request for an email without an account like
+// SendMagicLink would, so the response doesn't reveal who has an account. The
+// poll token matches no link and polls as pending until it expires.
+func DecoyMagicLink(opts MagicLinkOptions) (string, time.Time, error) {
+	if opts.RedirectTo != "" {
+		if err := ValidateRedirectURL(opts.RedirectTo); err != nil {
+			return "", time.Time{}, err
+		}
+	}
+
+	pollToken, err := utils.GenerateToken(magicLinkTokenBytes)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return pollToken, time.Now().Add(config.GetMagicLinkTTL()), nil
+}
+
+// VerifyMagicLink redeems a clicked link. It returns the user when the session
+// should be created on the clicking device, or nil when the link only approved
+// the login for a same-device link clicked elsewhere. pollToken proves the click
+// comes from the requesting device and may be empty.
+func VerifyMagicLink(ctx context.Context, token, pollToken string) (*ent.User, *ent.MagicLink, error) {
+	link, err := database.DB.MagicLink.Query().
+		Where(
+			magiclink.TokenHash(utils.HashToken(token)),
+			magiclink.StatusEQ(magiclink.StatusPending),
+			magiclink.ExpiresAtGT(time.Now()),
+		).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired link")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := magiclink.StatusConsumed
+	if link.SameDevice && utils.HashToken(pollToken) != link.PollTokenHash {
+		next = magiclink.StatusApproved
+	}
+
+	// Conditional on the status so a link can't be redeemed twice concurrently
+	updated, err := database.DB.MagicLink.Update().
+		Where(
+			magiclink.ID(link.ID),
+			magiclink.StatusEQ(magiclink.StatusPending),
+		).
+		SetStatus(next).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updated == 0 {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired link")
+	}
+
+	// Clicking the link proves control of the address
+	if err := markEmailVerified(ctx, link.Edges.User); err != nil {
+		return nil, nil, err
+	}
+
+	if next == magiclink.StatusApproved {
+		return nil, link, nil
+	}
+	return link.Edges.User, link, nil
+}
+
+// PollMagicLink is called by the requesting device until the link is clicked or
+// expires. It returns the user once a same-device link was approved elsewhere,
+// and nil while the link is still pending. Unknown and expired poll tokens also
+// look pending, otherwise polling would tell links for real accounts apart from
+// those of DecoyMagicLink.
+func PollMagicLink(ctx context.Context, pollToken string) (*ent.User, *ent.MagicLink, error) {
+	link, err := database.DB.MagicLink.Query().
+		Where(
+			magiclink.PollTokenHash(utils.HashToken(pollToken)),
+			magiclink.ExpiresAtGT(time.Now()),
+		).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch link.Status {
+	case magiclink.StatusPending:
+		return nil, link, nil
+	case magiclink.StatusConsumed:
+		return nil, nil, fiber.NewError(fiber.StatusGone, "Link was already used")
+	}
+
+	updated, err := database.DB.MagicLink.Update().
+		Where(
+			magiclink.ID(link.ID),
+			magiclink.StatusEQ(magiclink.StatusApproved),
+		).
+		SetStatus(magiclink.StatusConsumed).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if updated == 0 {
+		return nil, nil, fiber.NewError(fiber.StatusGone, "Link was already used")
+	}
+
+	return link.Edges.User, link, nil
+}
@@ -0,0 +1,439 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/accountlink"
+	"github.com/NikSchaefer/go-fiber/ent/mfachallenge"
+	"github.com/NikSchaefer/go-fiber/ent/recoverycode"
+	"github.com/NikSchaefer/go-fiber/ent/totpcredential"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/totp"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	// Unambiguous characters so codes survive being written down
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+	mfaChallengeBytes    = 32
+	// mfaMaxAttempts burns a challenge after this many wrong codes
+	mfaMaxAttempts = 5
+	// totpSkew accepts codes one step either side of the current one
+	totpSkew = 1
+)
+
+// getConfirmedTOTP returns the user's confirmed TOTP credential, or nil if two-factor is off
+func getConfirmedTOTP(ctx context.Context, u *ent.User) (*ent.TOTPCredential, error) {
+	cred, err := database.DB.TOTPCredential.Query().
+		Where(
+			totpcredential.HasUserWith(user.IDEQ(u.ID)),
+			totpcredential.ConfirmedAtNotNil(),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return cred, err
+}
+
+// HasMFAEnabled reports whether logging in requires a second factor
+func HasMFAEnabled(ctx context.Context, u *ent.User) (bool, error) {
+	cred, err := getConfirmedTOTP(ctx, u)
+	if err != nil {
+		return false, err
+	}
+	return cred != nil, nil
+}
+
+// BeginTOTPEnrollment creates a fresh, unconfirmed TOTP secret for the user and
+// returns it along with the otpauth URI to render as a QR code
+func BeginTOTPEnrollment(ctx context.Context, u *ent.User) (string, string, error) {
+	enabled, err := HasMFAEnabled(ctx, u)
+	if err != nil {
+		return "", "", err
+	}
+	if enabled {
+		return "", "", fiber.NewError(fiber.StatusConflict, "Two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Restarting enrollment replaces any secret that was never confirmed
+	_, err = tx.TOTPCredential.Delete().
+		Where(totpcredential.HasUserWith(user.IDEQ(u.ID))).
+		Exec(ctx)
+	if err != nil {
+		return "", "", utils.RollbackTx(tx, err)
+	}
+
+	_, err = tx.TOTPCredential.Create().
+		SetUser(u).
+		SetSecret(secret).
+		Save(ctx)
+	if err != nil {
+		return "", "", utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", "", err
+	}
+
+	return secret, totp.URI(secret, config.GetMFAIssuer(), u.Email), nil
+}
+
+// ConfirmTOTPEnrollment enables two-factor once the user proves their app
+// produces valid codes, and returns the initial recovery codes
+func ConfirmTOTPEnrollment(ctx context.Context, u *ent.User, code string) ([]string, error) {
+	cred, err := database.DB.TOTPCredential.Query().
+		Where(
+			totpcredential.HasUserWith(user.IDEQ(u.ID)),
+			totpcredential.ConfirmedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fiber.NewError(fiber.StatusNotFound, "No pending two-factor enrollment")
+		}
+		return nil, err
+	}
+
+	if err := verifyThrottledTOTP(ctx, u, cred, code); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.TOTPCredential.UpdateOne(cred).
+		SetConfirmedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	codes, err := replaceRecoveryCodes(ctx, tx, u)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// DisableTOTP turns two-factor off after checking a current code or recovery code
+func DisableTOTP(ctx context.Context, u *ent.User, code string, recoveryCode string) error {
+	if err := VerifySecondFactor(ctx, u, code, recoveryCode); err != nil {
+		return err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.TOTPCredential.Delete().
+		Where(totpcredential.HasUserWith(user.IDEQ(u.ID))).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	_, err = tx.RecoveryCode.Delete().
+		Where(recoverycode.HasUserWith(user.IDEQ(u.ID))).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// RegenerateRecoveryCodes invalidates all recovery codes and issues a new set.
+// Requires an authenticator code, since the old recovery codes may be what leaked.
+func RegenerateRecoveryCodes(ctx context.Context, u *ent.User, code string) ([]string, error) {
+	cred, err := getConfirmedTOTP(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Two-factor authentication is not enabled")
+	}
+
+	if err := verifyThrottledTOTP(ctx, u, cred, code); err != nil {
+		return nil, err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes, err := replaceRecoveryCodes(ctx, tx, u)
+	if err != nil {
+		return nil, utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// VerifySecondFactor checks either an authenticator code or an unused recovery code
+func VerifySecondFactor(ctx context.Context, u *ent.User, code string, recoveryCode string) error {
+	cred, err := getConfirmedTOTP(ctx, u)
+	if err != nil {
+		return err
+	}
+	if cred == nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Two-factor authentication is not enabled")
+	}
+
+	// Limits guesses per user, across challenges and re-authentications
+	if err := beginSecondFactorAttempt(ctx, u); err != nil {
+		return err
+	}
+
+	if code != "" {
+		ok, err := verifyTOTP(ctx, cred, code)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return resetSecondFactorFailures(ctx, u)
+		}
+	} else if recoveryCode != "" {
+		// Only a still unused code flips, which also makes concurrent use of the same code fail
+		used, err := database.DB.RecoveryCode.Update().
+			Where(
+				recoverycode.HasUserWith(user.IDEQ(u.ID)),
+				recoverycode.CodeHash(utils.HashToken(normalizeRecoveryCode(recoveryCode))),
+				recoverycode.UsedAtIsNil(),
+			).
+			SetUsedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if used > 0 {
+			return resetSecondFactorFailures(ctx, u)
+		}
+	}
+
+	return fiber.NewError(fiber.StatusUnauthorized, "Invalid code")
+}
+
+// verifyThrottledTOTP checks an authenticator code against the same per-user
+// limit as VerifySecondFactor, so no endpoint taking a code allows more guesses
+func verifyThrottledTOTP(ctx context.Context, u *ent.User, cred *ent.TOTPCredential, code string) error {
+	if err := beginSecondFactorAttempt(ctx, u); err != nil {
+		return err
+	}
+
+	ok, err := verifyTOTP(ctx, cred, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid code")
+	}
+
+	return resetSecondFactorFailures(ctx, u)
+}
+
+// verifyTOTP validates a code and records its time step so it cannot be used twice
+func verifyTOTP(ctx context.Context, cred *ent.TOTPCredential, code string) (bool, error) {
+	step, ok := totp.Validate(cred.Secret, code, time.Now(), totpSkew)
+	if !ok {
+		return false, nil
+	}
+
+	updated, err := database.DB.TOTPCredential.Update().
+		Where(
+			totpcredential.ID(cred.ID),
+			totpcredential.LastUsedStepLT(step),
+		).
+		SetLastUsedStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return updated > 0, nil
+}
+
+// replaceRecoveryCodes deletes the user's recovery codes and stores hashes of a new set
+func replaceRecoveryCodes(ctx context.Context, tx *ent.Tx, u *ent.User) ([]string, error) {
+	_, err := tx.RecoveryCode.Delete().
+		Where(recoverycode.HasUserWith(user.IDEQ(u.ID))).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, recoveryCodeCount)
+	builders := make([]*ent.RecoveryCodeCreate, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		builders[i] = tx.RecoveryCode.Create().
+			SetUser(u).
+			SetCodeHash(utils.HashToken(normalizeRecoveryCode(code)))
+	}
+
+	if _, err := tx.RecoveryCode.CreateBulk(builders...).Save(ctx); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// generateRecoveryCode returns a code formatted as two dash separated halves
+func generateRecoveryCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	for i := 0; i < recoveryCodeLength; i++ {
+		if i == recoveryCodeLength/2 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(recoveryCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
+
+// CreateMFAChallenge records a completed first factor and returns the token
+// the client exchanges for a session together with the second factor
+func CreateMFAChallenge(ctx context.Context, u *ent.User, method mfachallenge.Method) (string, error) {
+	token, err := utils.GenerateToken(mfaChallengeBytes)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = database.DB.MFAChallenge.Create().
+		SetUser(u).
+		SetMethod(method).
+		SetTokenHash(utils.HashToken(token)).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// CreateAccountLinkMFAChallenge is CreateMFAChallenge for a verified account
+// link, which CompleteMFAChallenge returns so it is only linked after the
+// second factor. An earlier challenge for the same link is replaced.
+func CreateAccountLinkMFAChallenge(ctx context.Context, u *ent.User, link *ent.AccountLink, method mfachallenge.Method) (string, error) {
+	token, err := utils.GenerateToken(mfaChallengeBytes)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.MFAChallenge.Delete().
+		Where(mfachallenge.HasAccountLinkWith(accountlink.ID(link.ID))).
+		Exec(ctx)
+	if err != nil {
+		return "", utils.RollbackTx(tx, err)
+	}
+
+	_, err = tx.MFAChallenge.Create().
+		SetUser(u).
+		SetAccountLink(link).
+		SetMethod(method).
+		SetTokenHash(utils.HashToken(token)).
+		Save(ctx)
+	if err != nil {
+		return "", utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// CompleteMFAChallenge verifies the second factor for a challenge and returns
+// the user to log in, and the account link to complete if the challenge has
+// one. Challenges are single use and burn after too many wrong codes.
+func CompleteMFAChallenge(ctx context.Context, token string, code string, recoveryCode string) (*ent.User, *ent.MFAChallenge, error) {
+	db := database.DB
+
+	challenge, err := db.MFAChallenge.Query().
+		Where(mfachallenge.TokenHash(utils.HashToken(token))).
+		WithUser().
+		WithAccountLink().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired challenge")
+	}
+
+	if time.Now().After(challenge.ExpiresAt) || challenge.Attempts >= mfaMaxAttempts {
+		_ = db.MFAChallenge.DeleteOne(challenge).Exec(ctx)
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired challenge")
+	}
+
+	err = VerifySecondFactor(ctx, challenge.Edges.User, code, recoveryCode)
+	if err != nil {
+		if _, ok := err.(*fiber.Error); ok {
+			_ = challenge.Update().AddAttempts(1).Exec(ctx)
+		}
+		return nil, nil, err
+	}
+
+	// Deleting is what makes the challenge single use, so only one caller may succeed
+	deleted, err := db.MFAChallenge.Delete().
+		Where(mfachallenge.ID(challenge.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if deleted == 0 {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired challenge")
+	}
+
+	return challenge.Edges.User, challenge, nil
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/enttest"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/totp"
+	"github.com/gofiber/fiber/v2"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// setupMFAUser points the database at a fresh in-memory SQLite with one user,
+// and lowers the second factor lockout to three wrong codes
+func setupMFAUser(t *testing.T) *ent.User {
+	t.Helper()
+
+	t.Setenv("LOGIN_ACCOUNT_BACKOFF_AFTER", "3")
+	t.Setenv("LOGIN_ACCOUNT_LOCKOUT_AFTER", "3")
+
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	database.DB = client
+
+	return client.User.Create().
+		SetEmail("jane@example.com").
+		SetEmailVerified(true).
+		SaveX(t.Context())
+}
+
+func currentCode(t *testing.T, secret string) string {
+	t.Helper()
+
+	code, err := totp.CodeAt(secret, totp.Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+// wrongCode is a valid looking code from well outside the accepted skew
+func wrongCode(t *testing.T, secret string) string {
+	t.Helper()
+
+	code, err := totp.CodeAt(secret, totp.Step(time.Now().Add(-time.Hour)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestRegenerateRecoveryCodesIsThrottled(t *testing.T) {
+	u := setupMFAUser(t)
+
+	secret, _, err := BeginTOTPEnrollment(t.Context(), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ConfirmTOTPEnrollment(t.Context(), u, currentCode(t, secret)); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := RegenerateRecoveryCodes(t.Context(), u, wrongCode(t, secret))
+		expectStatus(t, err, fiber.StatusUnauthorized)
+	}
+
+	_, err = RegenerateRecoveryCodes(t.Context(), u, currentCode(t, secret))
+	expectStatus(t, err, fiber.StatusTooManyRequests)
+
+	// The lock is per user, not per endpoint
+	err = VerifySecondFactor(t.Context(), u, currentCode(t, secret), "")
+	expectStatus(t, err, fiber.StatusTooManyRequests)
+}
+
+func TestConfirmTOTPEnrollmentIsThrottled(t *testing.T) {
+	u := setupMFAUser(t)
+
+	secret, _, err := BeginTOTPEnrollment(t.Context(), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := ConfirmTOTPEnrollment(t.Context(), u, wrongCode(t, secret))
+		expectStatus(t, err, fiber.StatusUnauthorized)
+	}
+
+	_, err = ConfirmTOTPEnrollment(t.Context(), u, currentCode(t, secret))
+	expectStatus(t, err, fiber.StatusTooManyRequests)
+}
+
+func TestCorrectCodeClearsSecondFactorFailures(t *testing.T) {
+	u := setupMFAUser(t)
+
+	secret, _, err := BeginTOTPEnrollment(t.Context(), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err := ConfirmTOTPEnrollment(t.Context(), u, wrongCode(t, secret))
+		expectStatus(t, err, fiber.StatusUnauthorized)
+	}
+	codes, err := ConfirmTOTPEnrollment(t.Context(), u, currentCode(t, secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two more wrong codes stay under the limit again
+	for i := 0; i < 2; i++ {
+		err := VerifySecondFactor(t.Context(), u, "", "not-a-code")
+		expectStatus(t, err, fiber.StatusUnauthorized)
+	}
+	if err := VerifySecondFactor(t.Context(), u, "", codes[0]); err != nil {
+		t.Fatalf("recovery code after the failures were cleared: %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/oauthflow"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oauthStateBytes = 32
+	oauthNonceBytes = 16
+)
+
+// OAuthFlowOptions are chosen by the client starting an authorization request
+type OAuthFlowOptions struct {
+	Purpose oauthflow.Purpose
+	// User is who the identity gets linked to, for the link purpose
+	User *ent.User
+	// ReturnTo must be on one of the ALLOWED_ORIGINS, see ValidateRedirectURL
+	ReturnTo string
+	// PKCE is false for providers that don't support it
+	PKCE bool
+}
+
+// StartOAuthFlow records a new authorization request and returns its state,
+// nonce and PKCE verifier for building the provider's authorization URL
+func StartOAuthFlow(ctx context.Context, provider string, opts OAuthFlowOptions) (*ent.OAuthFlow, string, error) {
+	if opts.ReturnTo != "" {
+		if err := ValidateRedirectURL(opts.ReturnTo); err != nil {
+			return nil, "", err
+		}
+	}
+
+	state, err := utils.GenerateToken(oauthStateBytes)
+	if err != nil {
+		return nil, "", err
+	}
+	nonce, err := utils.GenerateToken(oauthNonceBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Nobody is coming back from the ones that expired, so clear them out on the way
+	_, err = database.DB.OAuthFlow.Delete().
+		Where(oauthflow.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	create := database.DB.OAuthFlow.Create().
+		SetStateHash(utils.HashToken(state)).
+		SetProvider(provider).
+		SetPurpose(opts.Purpose).
+		SetNonce(nonce).
+		SetReturnTo(opts.ReturnTo)
+	if opts.PKCE {
+		create.SetCodeVerifier(oauth2.GenerateVerifier())
+	}
+	if opts.User != nil {
+		create.SetUser(opts.User)
+	}
+
+	flow, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return flow, state, nil
+}
+
+// AuthCodeURL builds the provider's authorization URL for the flow
+func (p *OAuthProvider) AuthCodeURL(flow *ent.OAuthFlow, state string) string {
+	opts := []oauth2.AuthCodeOption{
+		oauth2.AccessTypeOffline,
+		oauth2.SetAuthURLParam("nonce", flow.Nonce),
+	}
+	if flow.CodeVerifier != "" {
+		opts = append(opts, oauth2.S256ChallengeOption(flow.CodeVerifier))
+	}
+	return p.Config.AuthCodeURL(state, opts...)
+}
+
+// ConsumeOAuthFlow redeems the flow a callback's state belongs to. Flows are
+// single use, expire after config.GetOAuthFlowTTL and must match the provider,
+// purpose and, for linking, the user. Check this before exchanging the code.
+func ConsumeOAuthFlow(ctx context.Context, provider, state string, purpose oauthflow.Purpose, u *ent.User) (*ent.OAuthFlow, error) {
+	invalid := fiber.NewError(fiber.StatusBadRequest, "OAuth state is invalid or has expired, start again")
+
+	flow, err := database.DB.OAuthFlow.Query().
+		Where(oauthflow.StateHash(utils.HashToken(state))).
+		WithUser().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, invalid
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete before checking anything else, a state is burned by its first use
+	deleted, err := database.DB.OAuthFlow.Delete().
+		Where(oauthflow.ID(flow.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, invalid
+	}
+
+	if time.Now().After(flow.ExpiresAt) || flow.Provider != provider || flow.Purpose != purpose {
+		return nil, invalid
+	}
+	if purpose == oauthflow.PurposeLink && (u == nil || flow.Edges.User == nil || flow.Edges.User.ID != u.ID) {
+		return nil, invalid
+	}
+
+	return flow, nil
+}
+
+// Exchange redeems the authorization code with the flow's PKCE verifier. An
+// ID token in the response must carry the flow's nonce; it came straight from
+// the token endpoint over TLS, which OpenID Connect accepts in place of
+// checking its signature.
+func (p *OAuthProvider) Exchange(ctx context.Context, flow *ent.OAuthFlow, code string) (*oauth2.Token, error) {
+	var opts []oauth2.AuthCodeOption
+	if flow.CodeVerifier != "" {
+		opts = append(opts, oauth2.VerifierOption(flow.CodeVerifier))
+	}
+
+	token, err := p.Config.Exchange(ctx, code, opts...)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid authorization code")
+	}
+
+	if idToken, _ := token.Extra("id_token").(string); idToken != "" {
+		claims := jwt.MapClaims{}
+		_, _, err := jwt.NewParser().ParseUnverified(idToken, claims)
+		if err != nil {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid ID token")
+		}
+		if nonce, _ := claims["nonce"].(string); nonce != flow.Nonce {
+			return nil, fiber.NewError(fiber.StatusBadRequest, "ID token nonce does not match")
+		}
+	}
+
+	return token, nil
+}
+
+// appleNonce is what the flow's nonce is sent to Apple as, matching the
+// hashed nonces native apps send so VerifyAppleIDToken checks both alike
+func appleNonce(nonce string) string {
+	sum := sha256.Sum256([]byte(nonce))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,262 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/gofiber/fiber/v2"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/github"
+	"golang.org/x/oauth2/gitlab"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/microsoft"
+)
+
+// oauthProviderDefinition declares how to talk to an OAuth provider. Client
+// credentials come from the environment, see config.GetOAuthClient.
+type oauthProviderDefinition struct {
+	Endpoint    oauth2.Endpoint
+	Scopes      []string
+	UserInfoURL string
+	// Discovery fetches the endpoints from the OIDC issuer instead
+	Discovery bool
+	// Profile fetches the user's profile and normalizes it for HandleOauthLogin
+	Profile func(ctx context.Context, client *http.Client, userInfoURL string) (*HandleOauthLoginStruct, error)
+}
+
+var oauthProviderDefinitions = map[string]oauthProviderDefinition{
+	"google": {
+		Endpoint:    google.Endpoint,
+		Scopes:      []string{"openid", "email", "profile"},
+		UserInfoURL: "https://openidconnect.googleapis.com/v1/userinfo",
+		Profile:     fetchOIDCProfile,
+	},
+	"github": {
+		Endpoint:    github.Endpoint,
+		Scopes:      []string{"read:user", "user:email"},
+		UserInfoURL: "https://api.github.com/user",
+		Profile:     fetchGitHubProfile,
+	},
+	"microsoft": {
+		Endpoint:    microsoft.AzureADEndpoint("common"),
+		Scopes:      []string{"openid", "email", "profile"},
+		UserInfoURL: "https://graph.microsoft.com/oidc/userinfo",
+		Profile:     fetchOIDCProfile,
+	},
+	"gitlab": {
+		Endpoint:    gitlab.Endpoint,
+		Scopes:      []string{"openid", "email", "profile"},
+		UserInfoURL: "https://gitlab.com/oauth/userinfo",
+		Profile:     fetchOIDCProfile,
+	},
+	"oidc": {
+		Discovery: true,
+		Profile:   fetchOIDCProfile,
+	},
+}
+
+// OAuthProvider is a configured provider from the registry
+type OAuthProvider struct {
+	Name        string
+	Config      *oauth2.Config
+	userInfoURL string
+	profile     func(ctx context.Context, client *http.Client, userInfoURL string) (*HandleOauthLoginStruct, error)
+}
+
+// Profile fetches the user's normalized profile with an access token
+func (p *OAuthProvider) Profile(ctx context.Context, token *oauth2.Token) (*HandleOauthLoginStruct, error) {
+	profile, err := p.profile(ctx, p.Config.Client(ctx, token), p.userInfoURL)
+	if err != nil {
+		return nil, err
+	}
+	profile.Type = p.Name
+	// Profile.avatar_url holds up to 2048 characters, an odd picture shouldn't fail the login
+	if profile.AvatarURL != nil && (*profile.AvatarURL == "" || len(*profile.AvatarURL) > 2048) {
+		profile.AvatarURL = nil
+	}
+	return profile, nil
+}
+
+// GetOAuthProvider looks up a provider by name. Unknown providers and ones
+// without credentials are reported as not found.
+func GetOAuthProvider(ctx context.Context, name string) (*OAuthProvider, error) {
+	definition, ok := oauthProviderDefinitions[name]
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusNotFound, "Unknown OAuth provider")
+	}
+	client, ok := config.GetOAuthClient(name)
+	if !ok {
+		return nil, fiber.NewError(fiber.StatusNotFound, "OAuth provider is not enabled")
+	}
+
+	endpoint, scopes, userInfoURL := definition.Endpoint, definition.Scopes, definition.UserInfoURL
+	if definition.Discovery {
+		discovery, err := discoverOIDC(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		}
+		scopes = config.GetOIDCScopes()
+		userInfoURL = discovery.UserInfoEndpoint
+	}
+
+	return &OAuthProvider{
+		Name: name,
+		Config: &oauth2.Config{
+			ClientID:     client.ID,
+			ClientSecret: client.Secret,
+			RedirectURL:  client.RedirectURL,
+			Scopes:       scopes,
+			Endpoint:     endpoint,
+		},
+		userInfoURL: userInfoURL,
+		profile:     definition.Profile,
+	}, nil
+}
+
+// EnabledOAuthProviders lists the providers that have credentials configured
+func EnabledOAuthProviders() []string {
+	var names []string
+	for name := range oauthProviderDefinitions {
+		if _, ok := config.GetOAuthClient(name); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserInfoEndpoint      string `json:"userinfo_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+var (
+	oidcDiscoveryMu     sync.Mutex
+	oidcDiscoveryCached *oidcDiscovery
+)
+
+// discoverOIDC fetches the generic provider's metadata once, retrying on the
+// next login if it failed
+func discoverOIDC(ctx context.Context) (*oidcDiscovery, error) {
+	oidcDiscoveryMu.Lock()
+	defer oidcDiscoveryMu.Unlock()
+
+	if oidcDiscoveryCached != nil {
+		return oidcDiscoveryCached, nil
+	}
+
+	issuer := config.GetOIDCIssuerURL()
+	if issuer == "" {
+		return nil, fiber.NewError(fiber.StatusNotFound, "OAuth provider is not enabled")
+	}
+
+	var discovery oidcDiscovery
+	if err := getJSON(ctx, http.DefaultClient, issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("oidc discovery: issuer %q does not match %q", discovery.Issuer, issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.UserInfoEndpoint == "" {
+		return nil, errors.New("oidc discovery: provider metadata is missing endpoints")
+	}
+
+	oidcDiscoveryCached = &discovery
+	return oidcDiscoveryCached, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// fetchOIDCProfile reads the standard OpenID Connect userinfo claims
+func fetchOIDCProfile(ctx context.Context, client *http.Client, userInfoURL string) (*HandleOauthLoginStruct, error) {
+	var claims struct {
+		Subject       string      `json:"sub"`
+		Email         string      `json:"email"`
+		EmailVerified interface{} `json:"email_verified"`
+		Name          string      `json:"name"`
+		Picture       string      `json:"picture"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &claims); err != nil {
+		return nil, err
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("userinfo response has no subject")
+	}
+
+	return &HandleOauthLoginStruct{
+		Email:         claims.Email,
+		EmailVerified: claimBool(claims.EmailVerified),
+		Name:          claims.Name,
+		ProviderID:    claims.Subject,
+		AvatarURL:     &claims.Picture,
+	}, nil
+}
+
+// fetchGitHubProfile reads the user and picks their primary verified email,
+// since the public profile email is optional and unverified
+func fetchGitHubProfile(ctx context.Context, client *http.Client, userInfoURL string) (*HandleOauthLoginStruct, error) {
+	var profile struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, client, userInfoURL, &profile); err != nil {
+		return nil, err
+	}
+
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, client, userInfoURL+"/emails", &emails); err != nil {
+		return nil, err
+	}
+
+	result := &HandleOauthLoginStruct{
+		Name:       profile.Name,
+		ProviderID: strconv.FormatInt(profile.ID, 10),
+		AvatarURL:  &profile.AvatarURL,
+	}
+	if result.Name == "" {
+		result.Name = profile.Login
+	}
+	for _, email := range emails {
+		if email.Primary {
+			result.Email = email.Email
+			result.EmailVerified = email.Verified
+		}
+	}
+
+	return result, nil
+}
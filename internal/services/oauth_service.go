@@ -4,22 +4,24 @@ import (
 	"context"
 	"strings"
 
-	"github.com/google/uuid"
+	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/NikSchaefer/go-fiber/ent"
 	"github.com/NikSchaefer/go-fiber/ent/account"
 	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
-	"github.com/NikSchaefer/go-fiber/pkg/analytics"
 	"github.com/NikSchaefer/go-fiber/pkg/utils"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 type CreateOAuthUserStruct struct {
-	Email      string    `validate:"required,email"`
-	Name       string    `validate:"required,min=2,max=100"`
-	Type       string    `validate:"required,oneof=google apple"`
-	ProviderID string    `validate:"required"`
-	AvatarURL  *string   `validate:"omitempty,url"`
+	Email         string `validate:"required,email"`
+	EmailVerified bool
+	Name          string  `validate:"required,min=2,max=100"`
+	Type          string  `validate:"required,oneof=google apple github microsoft gitlab oidc"`
+	ProviderID    string  `validate:"required"`
+	AvatarURL     *string `validate:"omitempty,url"`
 }
 
 func CreateOAuthUser(data CreateOAuthUserStruct) (*ent.User, error) {
@@ -43,7 +45,7 @@ func CreateOAuthUser(data CreateOAuthUserStruct) (*ent.User, error) {
 
 	userEntity, err := tx.User.Create().
 		SetEmail(strings.ToLower(data.Email)).
-		SetEmailVerified(true).
+		SetEmailVerified(data.EmailVerified).
 		Save(ctx)
 	if err != nil {
 		return nil, utils.RollbackTx(tx, err)
@@ -52,6 +54,7 @@ func CreateOAuthUser(data CreateOAuthUserStruct) (*ent.User, error) {
 	_, err = tx.Profile.Create().
 		SetUser(userEntity).
 		SetName(data.Name).
+		SetNillableAvatarURL(data.AvatarURL).
 		Save(ctx)
 	if err != nil {
 		return nil, utils.RollbackTx(tx, err)
@@ -75,9 +78,11 @@ func CreateOAuthUser(data CreateOAuthUserStruct) (*ent.User, error) {
 
 type AddOauthAccountToUserStruct struct {
 	UserID     uuid.UUID `validate:"required"`
-	Type       string    `validate:"required,oneof=google apple"`
+	Type       string    `validate:"required,oneof=google apple github microsoft gitlab oidc"`
 	ProviderID string    `validate:"required"`
 	AvatarURL  *string   `validate:"omitempty,url"`
+	// Meta is recorded in the audit trail
+	Meta SessionMetadata
 }
 
 func AddOauthAccountToUser(data AddOauthAccountToUserStruct) (*ent.User, error) {
@@ -94,30 +99,40 @@ func AddOauthAccountToUser(data AddOauthAccountToUserStruct) (*ent.User, error)
 		return nil, err
 	}
 
-	_, err = db.Account.Create().
-		SetUser(user).
-		SetType(account.Type(data.Type)).
-		SetProviderID(data.ProviderID).
-		Save(ctx)
+	_, err = linkAccount(ctx, user, account.Type(data.Type), data.ProviderID, data.Meta)
 	if err != nil {
 		return nil, err
 	}
 
-	analytics.TrackEventWithUser("oauth_account_added", map[string]interface{}{
-		"type": data.Type,
-	}, user)
+	// Use the provider's picture unless the user already has an avatar
+	if data.AvatarURL != nil && user.Edges.Profile != nil && user.Edges.Profile.AvatarURL == nil {
+		err = user.Edges.Profile.Update().
+			SetAvatarURL(*data.AvatarURL).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return user, nil
 }
 
 type HandleOauthLoginStruct struct {
-	Email      string  `validate:"omitempty,email"`
-	Name       string  `validate:"omitempty,min=2,max=100"`
-	Type       string  `validate:"required,oneof=google apple"`
-	ProviderID string  `validate:"required"`
-	AvatarURL  *string `validate:"omitempty,url"`
+	Email string `validate:"omitempty,email"`
+	// EmailVerified is whether the provider vouches for the address
+	EmailVerified bool
+	Name          string  `validate:"omitempty,min=2,max=100"`
+	Type          string  `validate:"required,oneof=google apple github microsoft gitlab oidc"`
+	ProviderID    string  `validate:"required"`
+	AvatarURL     *string `validate:"omitempty,url"`
+	// Meta is recorded in the audit trail if the login links an account
+	Meta SessionMetadata
 }
 
+// HandleOauthLogin returns the user the provider identity belongs to, creating
+// one if the email is new. An identity matching an existing user by email is
+// linked according to config.GetOAuthLinkPolicy, which may return an
+// *AccountLinkRequiredError for the user to confirm the link first.
 func HandleOauthLogin(data HandleOauthLoginStruct) (*ent.User, error) {
 	err := validator.Validate(data)
 	if err != nil {
@@ -143,26 +158,41 @@ func HandleOauthLogin(data HandleOauthLoginStruct) (*ent.User, error) {
 	}
 
 	// Then try to find by email
-	u, err := db.User.Query().Where(user.Email(data.Email)).Only(ctx)
+	u, err := db.User.Query().Where(user.Email(strings.ToLower(data.Email))).Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			// No existing account found, create new one
 			return CreateOAuthUser(CreateOAuthUserStruct{
-				Email:      data.Email,
-				Name:       data.Name,
-				Type:       data.Type,
-				ProviderID: data.ProviderID,
-				AvatarURL:  data.AvatarURL,
+				Email:         data.Email,
+				EmailVerified: data.EmailVerified,
+				Name:          data.Name,
+				Type:          data.Type,
+				ProviderID:    data.ProviderID,
+				AvatarURL:     data.AvatarURL,
 			})
 		}
 		return nil, err
 	}
 
+	// Merging on email alone would hand the account to whoever controls the
+	// provider identity, so only do it when both sides verified the address
+	switch config.GetOAuthLinkPolicy() {
+	case "never":
+		return nil, fiber.NewError(fiber.StatusConflict, "An account with this email already exists, log in to it first")
+	case "confirm":
+		return nil, requireAccountLink(ctx, u, data)
+	default:
+		if !data.EmailVerified || !u.EmailVerified {
+			return nil, requireAccountLink(ctx, u, data)
+		}
+	}
+
 	// Found existing user by email - link the accounts
 	return AddOauthAccountToUser(AddOauthAccountToUserStruct{
 		UserID:     u.ID,
 		Type:       data.Type,
 		ProviderID: data.ProviderID,
 		AvatarURL:  data.AvatarURL,
+		Meta:       data.Meta,
 	})
 }
@@ -2,16 +2,158 @@ package services
 
 import (
 	"context"
+	"crypto/subtle"
+	"time"
 
+	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
 )
 
-func GenerateOTP(user *ent.User) (*ent.OTP, error) {
+// otpDigits is the length of the codes sent to users
+const otpDigits = 6
+
+// hashOTPCode salts the code with the OTP's ID, so equal codes never share a digest.
+// Six digits cannot withstand offline guessing, the short TTL and attempt limit
+// are what protect a code; the hash keeps it out of logs and backups.
+func hashOTPCode(id guuid.UUID, code string) string {
+	return utils.HashToken(id.String() + ":" + code)
+}
+
+// GenerateOTP issues a code of the given type and returns it. Only the hash is
+// stored, and any earlier unused code of the same type stops working.
+func GenerateOTP(ctx context.Context, u *ent.User, otpType otp.Type) (string, error) {
+	code, err := utils.GenerateNumericCode(otpDigits)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.OTP.Update().
+		Where(
+			otp.HasUserWith(user.IDEQ(u.ID)),
+			otp.TypeEQ(otpType),
+			otp.Used(false),
+		).
+		SetUsed(true).
+		Save(ctx)
+	if err != nil {
+		return "", utils.RollbackTx(tx, err)
+	}
+
+	id := guuid.New()
+	_, err = tx.OTP.Create().
+		SetID(id).
+		SetUser(u).
+		SetType(otpType).
+		SetCodeHash(hashOTPCode(id, code)).
+		SetExpiresAt(time.Now().Add(config.GetOTPTTL(string(otpType)))).
+		Save(ctx)
+	if err != nil {
+		return "", utils.RollbackTx(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+// VerifyOTPCode checks a code of the given type issued to the user and marks it used.
+// Every guess counts against the current code before it is compared, which is
+// burned once the configured number of attempts is reached.
+func VerifyOTPCode(ctx context.Context, u *ent.User, code string, otpType otp.Type) error {
+	o, err := CheckOTPCode(ctx, u, code, otpType)
+	if err != nil {
+		return err
+	}
+	return ConsumeOTP(ctx, o)
+}
+
+// CheckOTPCode counts and checks a guess like VerifyOTPCode, but leaves the code
+// live, for callers with more to check before it is redeemed with ConsumeOTP
+func CheckOTPCode(ctx context.Context, u *ent.User, code string, otpType otp.Type) (*ent.OTP, error) {
 	db := database.DB
-	ctx := context.Background()
+	maxAttempts := config.GetOTPMaxAttempts()
+	invalid := fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired code")
+
+	// Issuing a code invalidates the previous ones, so there is at most one live code
+	o, err := db.OTP.Query().
+		Where(
+			otp.HasUserWith(user.IDEQ(u.ID)),
+			otp.TypeEQ(otpType),
+			otp.Used(false),
+			otp.ExpiresAtGTE(time.Now()),
+			otp.AttemptsLT(maxAttempts),
+		).
+		Order(ent.Desc(otp.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	// Claim an attempt first, so concurrent guesses can't all slip in under the limit
+	claimed, err := db.OTP.Update().
+		Where(
+			otp.ID(o.ID),
+			otp.Used(false),
+			otp.AttemptsLT(maxAttempts),
+		).
+		AddAttempts(1).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if claimed == 0 {
+		return nil, invalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(o.CodeHash), []byte(hashOTPCode(o.ID, code))) != 1 {
+		_, err := db.OTP.Update().
+			Where(
+				otp.ID(o.ID),
+				otp.AttemptsGTE(maxAttempts),
+			).
+			SetUsed(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return nil, invalid
+	}
 
-	return db.OTP.Create().
-		SetUser(user).
+	return o, nil
+}
+
+// ConsumeOTP marks a code returned by CheckOTPCode used
+func ConsumeOTP(ctx context.Context, o *ent.OTP) error {
+	// Only an unused code flips, so the same code cannot be redeemed twice concurrently
+	used, err := database.DB.OTP.Update().
+		Where(
+			otp.ID(o.ID),
+			otp.Used(false),
+		).
+		SetUsed(true).
 		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if used == 0 {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired code")
+	}
+
+	return nil
 }
@@ -0,0 +1,368 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/passkey"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/ent/webauthnchallenge"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+)
+
+// passkeyUser adapts a user and their passkeys to the webauthn.User interface.
+// The user handle is the user ID, so a discoverable login resolves straight to the account.
+type passkeyUser struct {
+	user     *ent.User
+	passkeys []*ent.Passkey
+}
+
+func (p *passkeyUser) WebAuthnID() []byte {
+	id := p.user.ID
+	return id[:]
+}
+
+func (p *passkeyUser) WebAuthnName() string {
+	return p.user.Email
+}
+
+func (p *passkeyUser) WebAuthnDisplayName() string {
+	if p.user.Edges.Profile != nil && p.user.Edges.Profile.Name != "" {
+		return p.user.Edges.Profile.Name
+	}
+	return p.user.Email
+}
+
+func (p *passkeyUser) WebAuthnCredentials() []webauthn.Credential {
+	credentials := make([]webauthn.Credential, len(p.passkeys))
+	for i, pk := range p.passkeys {
+		transports := make([]protocol.AuthenticatorTransport, len(pk.Transports))
+		for j, t := range pk.Transports {
+			transports[j] = protocol.AuthenticatorTransport(t)
+		}
+
+		credentials[i] = webauthn.Credential{
+			ID:              pk.CredentialID,
+			PublicKey:       pk.PublicKey,
+			AttestationType: pk.AttestationType,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				BackupEligible: pk.BackupEligible,
+				BackupState:    pk.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    pk.Aaguid,
+				SignCount: pk.SignCount,
+			},
+		}
+	}
+	return credentials
+}
+
+// newWebAuthn builds the relying party from configuration
+func newWebAuthn() (*webauthn.WebAuthn, error) {
+	return webauthn.New(&webauthn.Config{
+		RPID:          config.GetWebAuthnRPID(),
+		RPDisplayName: config.GetWebAuthnRPName(),
+		RPOrigins:     config.GetWebAuthnOrigins(),
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementRequired,
+			UserVerification: protocol.VerificationPreferred,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login: webauthn.TimeoutConfig{
+				Enforce: true,
+				Timeout: config.GetWebAuthnChallengeTTL(),
+			},
+			Registration: webauthn.TimeoutConfig{
+				Enforce: true,
+				Timeout: config.GetWebAuthnChallengeTTL(),
+			},
+		},
+	})
+}
+
+// loadPasskeyUser fetches the user's passkeys for a ceremony
+func loadPasskeyUser(ctx context.Context, u *ent.User) (*passkeyUser, error) {
+	passkeys, err := database.DB.Passkey.Query().
+		Where(passkey.HasUserWith(user.IDEQ(u.ID))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &passkeyUser{user: u, passkeys: passkeys}, nil
+}
+
+// saveWebAuthnChallenge persists the ceremony state until the finish request
+func saveWebAuthnChallenge(ctx context.Context, u *ent.User, ceremony webauthnchallenge.Ceremony, session *webauthn.SessionData) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	// Anyone can start a passkey login, so clear out the abandoned ceremonies on the way
+	_, err = database.DB.WebAuthnChallenge.Delete().
+		Where(webauthnchallenge.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	create := database.DB.WebAuthnChallenge.Create().
+		SetChallenge(session.Challenge).
+		SetCeremony(ceremony).
+		SetSessionData(data)
+	if u != nil {
+		create.SetUser(u)
+	}
+
+	return create.Exec(ctx)
+}
+
+// consumeWebAuthnChallenge looks up and deletes the state of a ceremony, so each
+// challenge can only be answered once
+func consumeWebAuthnChallenge(ctx context.Context, challenge string, ceremony webauthnchallenge.Ceremony, u *ent.User) (*webauthn.SessionData, error) {
+	db := database.DB
+	invalid := fiber.NewError(fiber.StatusUnauthorized, "Invalid or expired passkey challenge")
+
+	query := db.WebAuthnChallenge.Query().
+		Where(
+			webauthnchallenge.Challenge(challenge),
+			webauthnchallenge.CeremonyEQ(ceremony),
+		)
+	if u != nil {
+		query.Where(webauthnchallenge.HasUserWith(user.IDEQ(u.ID)))
+	}
+
+	c, err := query.Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	deleted, err := db.WebAuthnChallenge.Delete().
+		Where(webauthnchallenge.ID(c.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 || time.Now().After(c.ExpiresAt) {
+		return nil, invalid
+	}
+
+	session := new(webauthn.SessionData)
+	if err := json.Unmarshal(c.SessionData, session); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// BeginPasskeyRegistration returns the creation options for the browser's
+// navigator.credentials.create call
+func BeginPasskeyRegistration(ctx context.Context, u *ent.User) (*protocol.CredentialCreation, error) {
+	w, err := newWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	pu, err := loadPasskeyUser(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stops the authenticator from registering a second passkey for the same account
+	exclusions := webauthn.Credentials(pu.WebAuthnCredentials()).CredentialDescriptors()
+
+	creation, session, err := w.BeginRegistration(pu, webauthn.WithExclusions(exclusions))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveWebAuthnChallenge(ctx, u, webauthnchallenge.CeremonyRegistration, session); err != nil {
+		return nil, err
+	}
+
+	return creation, nil
+}
+
+// FinishPasskeyRegistration verifies the authenticator's attestation response and stores the new passkey
+func FinishPasskeyRegistration(ctx context.Context, u *ent.User, name string, response []byte) (*ent.Passkey, error) {
+	w, err := newWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid passkey response")
+	}
+
+	session, err := consumeWebAuthnChallenge(ctx, parsed.Response.CollectedClientData.Challenge, webauthnchallenge.CeremonyRegistration, u)
+	if err != nil {
+		return nil, err
+	}
+
+	pu, err := loadPasskeyUser(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := w.CreateCredential(pu, *session, parsed)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Passkey verification failed")
+	}
+
+	transports := make([]string, len(credential.Transport))
+	for i, t := range credential.Transport {
+		transports[i] = string(t)
+	}
+
+	pk, err := database.DB.Passkey.Create().
+		SetUser(u).
+		SetName(name).
+		SetCredentialID(credential.ID).
+		SetPublicKey(credential.PublicKey).
+		SetAttestationType(credential.AttestationType).
+		SetTransports(transports).
+		SetAaguid(credential.Authenticator.AAGUID).
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupEligible(credential.Flags.BackupEligible).
+		SetBackupState(credential.Flags.BackupState).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, fiber.NewError(fiber.StatusConflict, "Passkey already registered")
+		}
+		return nil, err
+	}
+
+	return pk, nil
+}
+
+// BeginPasskeyLogin returns the request options for a discoverable login, where
+// the authenticator picks the account
+func BeginPasskeyLogin(ctx context.Context) (*protocol.CredentialAssertion, error) {
+	w, err := newWebAuthn()
+	if err != nil {
+		return nil, err
+	}
+
+	assertion, session, err := w.BeginDiscoverableLogin()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := saveWebAuthnChallenge(ctx, nil, webauthnchallenge.CeremonyLogin, session); err != nil {
+		return nil, err
+	}
+
+	return assertion, nil
+}
+
+// FinishPasskeyLogin verifies the authenticator's assertion and returns the user
+// it belongs to, and whether the authenticator verified the user with a PIN or
+// biometric. Without that a passkey only proves possession of the device.
+func FinishPasskeyLogin(ctx context.Context, response []byte) (*ent.User, bool, error) {
+	w, err := newWebAuthn()
+	if err != nil {
+		return nil, false, err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, false, fiber.NewError(fiber.StatusBadRequest, "Invalid passkey response")
+	}
+
+	session, err := consumeWebAuthnChallenge(ctx, parsed.Response.CollectedClientData.Challenge, webauthnchallenge.CeremonyLogin, nil)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var pu *passkeyUser
+	handler := func(rawID, userHandle []byte) (webauthn.User, error) {
+		userID, err := guuid.FromBytes(userHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		u, err := database.DB.User.Get(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		pu, err = loadPasskeyUser(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		return pu, nil
+	}
+
+	_, credential, err := w.ValidatePasskeyLogin(handler, *session, parsed)
+	if err != nil {
+		return nil, false, fiber.NewError(fiber.StatusUnauthorized, "Passkey verification failed")
+	}
+
+	var pk *ent.Passkey
+	for _, candidate := range pu.passkeys {
+		if bytes.Equal(candidate.CredentialID, credential.ID) {
+			pk = candidate
+			break
+		}
+	}
+	if pk == nil {
+		return nil, false, fiber.NewError(fiber.StatusUnauthorized, "Passkey verification failed")
+	}
+
+	if credential.Authenticator.CloneWarning {
+		log.Printf("security: passkey %s of user %s presented a non increasing sign count", pk.ID, pu.user.ID)
+		return nil, false, fiber.NewError(fiber.StatusUnauthorized, "Passkey verification failed")
+	}
+
+	err = pk.Update().
+		SetSignCount(credential.Authenticator.SignCount).
+		SetBackupState(credential.Flags.BackupState).
+		SetLastUsedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return pu.user, credential.Flags.UserVerified, nil
+}
+
+// ListPasskeys returns the user's passkeys, newest first
+func ListPasskeys(ctx context.Context, u *ent.User) ([]*ent.Passkey, error) {
+	return database.DB.Passkey.Query().
+		Where(passkey.HasUserWith(user.IDEQ(u.ID))).
+		Order(ent.Desc(passkey.FieldCreatedAt)).
+		All(ctx)
+}
+
+// DeletePasskey removes a passkey, provided it belongs to the user
+func DeletePasskey(ctx context.Context, u *ent.User, passkeyID guuid.UUID) error {
+	deleted, err := database.DB.Passkey.Delete().
+		Where(
+			passkey.ID(passkeyID),
+			passkey.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusNotFound, "Passkey not found")
+	}
+	return nil
+}
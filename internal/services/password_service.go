@@ -0,0 +1,40 @@
+package services
+
+import (
+	"context"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/pkg/password"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+)
+
+// HashNewPassword checks a password a user chose against the configured
+// policy and hashes it. Every path that sets a password goes through here.
+// userInputs are the user's email and name, which the password must not contain.
+func HashNewPassword(ctx context.Context, pw string, userInputs ...string) ([]byte, error) {
+	err := password.Validate(ctx, pw, userInputs...)
+	if err != nil {
+		if _, ok := err.(*password.PolicyError); ok {
+			return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		return nil, err
+	}
+
+	return utils.HashAndSalt([]byte(pw))
+}
+
+// PasswordUserInputs are what a user is known by, for HashNewPassword
+func PasswordUserInputs(ctx context.Context, u *ent.User) ([]string, error) {
+	inputs := []string{u.Email}
+
+	profile, err := u.QueryProfile().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	if profile != nil {
+		inputs = append(inputs, profile.Name)
+	}
+
+	return inputs, nil
+}
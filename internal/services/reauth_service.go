@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/account"
+	"github.com/NikSchaefer/go-fiber/ent/otp"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+)
+
+// Methods a user can re-authenticate with
+const (
+	ReauthMethodPassword = "password"
+	ReauthMethodOTP      = "otp"
+	ReauthMethodTOTP     = "totp"
+)
+
+// ReauthCredentials carries the proof of identity for a re-authentication; exactly one is checked
+type ReauthCredentials struct {
+	Password string
+	OTP      string
+	TOTPCode string
+}
+
+// GetAuthenticatedAt returns when the user last proved their identity on the
+// current session, or on the token family of a bearer token
+func GetAuthenticatedAt(ctx context.Context, s *ent.Session, claims *tokens.AccessClaims) (time.Time, error) {
+	if s != nil {
+		return s.AuthenticatedAt, nil
+	}
+
+	if claims != nil {
+		familyID, err := guuid.Parse(claims.SessionID)
+		if err != nil {
+			return time.Time{}, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token")
+		}
+		family, err := database.DB.TokenFamily.Get(ctx, familyID)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return family.AuthenticatedAt, nil
+	}
+
+	return time.Time{}, nil
+}
+
+// GetReauthMethods lists the ways the user can currently re-authenticate
+func GetReauthMethods(ctx context.Context, u *ent.User) ([]string, error) {
+	var methods []string
+
+	hasPassword, err := database.DB.Account.Query().
+		Where(
+			account.HasUserWith(user.IDEQ(u.ID)),
+			account.TypeEQ(account.TypePassword),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hasPassword {
+		methods = append(methods, ReauthMethodPassword)
+	}
+
+	methods = append(methods, ReauthMethodOTP)
+
+	hasTOTP, err := HasMFAEnabled(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+	if hasTOTP {
+		methods = append(methods, ReauthMethodTOTP)
+	}
+
+	return methods, nil
+}
+
+// Reauthenticate verifies the credentials and stamps the session or token family
+// as freshly authenticated
+func Reauthenticate(ctx context.Context, u *ent.User, s *ent.Session, claims *tokens.AccessClaims, creds ReauthCredentials, meta SessionMetadata) (time.Time, error) {
+	if s == nil && claims == nil {
+		return time.Time{}, fiber.NewError(fiber.StatusForbidden, "Re-authentication requires a session or bearer token")
+	}
+
+	if err := verifyReauthCredentials(ctx, u, creds, meta); err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+
+	if s != nil {
+		err := database.DB.Session.UpdateOne(s).
+			SetAuthenticatedAt(now).
+			Exec(ctx)
+		return now, err
+	}
+
+	familyID, err := guuid.Parse(claims.SessionID)
+	if err != nil {
+		return time.Time{}, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token")
+	}
+	err = database.DB.TokenFamily.UpdateOneID(familyID).
+		SetAuthenticatedAt(now).
+		Exec(ctx)
+	return now, err
+}
+
+// verifyReauthCredentials checks proof of identity from someone who may already
+// hold the session. Password guesses count against the same per-account and
+// per-IP limits as logins, so a stolen session can't be used to find the password.
+func verifyReauthCredentials(ctx context.Context, u *ent.User, creds ReauthCredentials, meta SessionMetadata) error {
+	switch {
+	case creds.Password != "":
+		if err := verifyPassword(ctx, u, creds.Password, meta); err != nil {
+			return err
+		}
+		return ResetLoginFailures(ctx, strings.ToLower(u.Email))
+	case creds.OTP != "":
+		return VerifyOTPCode(ctx, u, creds.OTP, otp.TypeReauthentication)
+	case creds.TOTPCode != "":
+		return VerifySecondFactor(ctx, u, creds.TOTPCode, "")
+	}
+
+	return fiber.NewError(fiber.StatusBadRequest, "Password, OTP or TOTP code is required")
+}
+
+// verifyPassword checks the user's password behind the login throttle of their
+// email, counting a wrong one as a failed login. Callers clear the failures once
+// the whole authentication succeeded.
+func verifyPassword(ctx context.Context, u *ent.User, password string, meta SessionMetadata) error {
+	identifier := strings.ToLower(u.Email)
+	wait, err := CheckLoginAllowed(ctx, identifier, meta.IPAddress)
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return fiber.NewError(fiber.StatusTooManyRequests, "Too many failed attempts, try again later")
+	}
+
+	acc, err := database.DB.Account.Query().
+		Where(
+			account.HasUserWith(user.IDEQ(u.ID)),
+			account.TypeEQ(account.TypePassword),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fiber.NewError(fiber.StatusBadRequest, "No password found for this user")
+		}
+		return err
+	}
+	if !utils.ComparePasswords(acc.PasswordHash, []byte(password)) {
+		if err := RecordLoginFailure(ctx, identifier, meta.IPAddress, u); err != nil {
+			return err
+		}
+		return fiber.NewError(fiber.StatusUnauthorized, "Password is incorrect")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/gofiber/fiber/v2"
+)
+
+// ValidateRedirectURL checks that target is an absolute http(s) URL on one of
+// the ALLOWED_ORIGINS, so login flows can't be turned into open redirects
+func ValidateRedirectURL(target string) error {
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" || parsed.User != nil {
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid redirect URL")
+	}
+
+	origin := strings.ToLower(parsed.Scheme + "://" + parsed.Host)
+	for _, allowed := range config.GetAllowedOriginList() {
+		if strings.ToLower(allowed) == origin {
+			return nil
+		}
+	}
+
+	return fiber.NewError(fiber.StatusBadRequest, "Redirect URL is not an allowed origin")
+}
@@ -2,39 +2,222 @@ package services
 
 import (
 	"context"
+	"strings"
+	"time"
 
-	"github.com/gofiber/fiber/v2"
-	guuid "github.com/google/uuid"
+	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/NikSchaefer/go-fiber/ent"
 	"github.com/NikSchaefer/go-fiber/ent/session"
+	"github.com/NikSchaefer/go-fiber/ent/user"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+	"github.com/mssola/useragent"
 )
 
-// ValidateSession checks if a session is valid and returns the associated user
-func ValidateSession(ctx context.Context, sessionID string) (*ent.User, error) {
-	if sessionID == "" {
+// sessionTokenBytes is the entropy of a session token handed to the client
+const sessionTokenBytes = 32
+
+// ValidateSession checks if a session token is valid and returns the session with the associated user loaded
+func ValidateSession(ctx context.Context, token string) (*ent.Session, error) {
+	if token == "" {
 		return nil, fiber.NewError(fiber.StatusUnauthorized, "No session provided")
 	}
 
-	id, err := guuid.Parse(sessionID)
-	if err != nil {
-		return nil, fiber.NewError(fiber.StatusBadRequest, "Invalid session format")
-	}
 	db := database.DB
 
-	session, err := db.Session.Query().
-		Where(session.ID(id)).
+	// Only the digest is stored, so a leaked table cannot be replayed as cookies
+	s, err := db.Session.Query().
+		Where(session.TokenHash(utils.HashToken(token))).
 		WithUser().
 		Only(ctx)
 	if err != nil {
-		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid session id")
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid session")
 	}
 
-	user, err := session.QueryUser().
-		Only(ctx)
-	if err != nil {
+	now := time.Now()
+	if now.After(s.Expires) || now.After(GetSessionDeadline(s)) {
+		// Expired sessions are useless, clean them up as we find them
+		_ = db.Session.DeleteOne(s).Exec(ctx)
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Session expired")
+	}
+
+	if s.Edges.User == nil {
 		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid session user")
 	}
 
-	return user, nil
+	return s, nil
+}
+
+// GetSessionDeadline returns the point after which a session is invalid no matter how active it is
+func GetSessionDeadline(s *ent.Session) time.Time {
+	return s.CreatedAt.Add(config.GetSessionAbsoluteLifetime())
+}
+
+// SessionMetadata describes the client a session is created from
+type SessionMetadata struct {
+	UserAgent string
+	IPAddress string
+}
+
+// GetSessionMetadata extracts the user agent and client IP from the request,
+// only trusting X-Forwarded-For when it was set by a configured proxy
+func GetSessionMetadata(c *fiber.Ctx) SessionMetadata {
+	return SessionMetadata{
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+		IPAddress: utils.ClientIP(
+			c.Context().RemoteIP().String(),
+			c.IPs(),
+			utils.ParseTrustedProxies(config.GetTrustedProxies()),
+		),
+	}
+}
+
+// CreateSession starts a new session for the user, recording where it came from.
+// The returned token is the only copy of the session secret and must be sent to the client.
+func CreateSession(ctx context.Context, u *ent.User, meta SessionMetadata) (*ent.Session, string, error) {
+	token, err := utils.GenerateToken(sessionTokenBytes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	create := database.DB.Session.Create().
+		SetUser(u).
+		SetTokenHash(utils.HashToken(token)).
+		SetIPAddress(meta.IPAddress)
+
+	if meta.UserAgent != "" {
+		ua := useragent.New(meta.UserAgent)
+		browser, browserVersion := ua.Browser()
+		osInfo := ua.OSInfo()
+
+		device := ua.Model()
+		if device == "" {
+			device = ua.Platform()
+		}
+		if ua.Bot() {
+			device = "Bot"
+		}
+
+		create.
+			SetUserAgent(truncate(meta.UserAgent, 512)).
+			SetDevice(truncate(device, 255)).
+			SetBrowser(truncate(strings.TrimSpace(browser+" "+browserVersion), 255)).
+			SetOs(truncate(strings.TrimSpace(osInfo.Name+" "+osInfo.Version), 255))
+	}
+
+	s, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	s.Edges.User = u
+
+	return s, token, nil
+}
+
+func truncate(s string, max int) string {
+	if len(s) > max {
+		return s[:max]
+	}
+	return s
+}
+
+// TouchSession records activity on a session. last_seen_at is only written once
+// the configured interval has passed, and the expiry is slid forward once the
+// renewal threshold has passed since it was last extended, never beyond the
+// absolute lifetime. Reports whether the expiry was renewed so the caller can
+// re-issue the cookie.
+func TouchSession(ctx context.Context, s *ent.Session) (*ent.Session, bool, error) {
+	now := time.Now()
+	idleTimeout := config.GetSessionIdleTimeout()
+
+	update := s.Update()
+	changed := false
+
+	if now.Sub(s.LastSeenAt) >= config.GetSessionLastSeenInterval() {
+		update.SetLastSeenAt(now)
+		changed = true
+	}
+
+	renewed := false
+	lastRenewed := s.Expires.Add(-idleTimeout)
+	if now.Sub(lastRenewed) >= config.GetSessionRenewalThreshold() {
+		expires := now.Add(idleTimeout)
+		if deadline := GetSessionDeadline(s); expires.After(deadline) {
+			expires = deadline
+		}
+		if expires.After(s.Expires) {
+			update.SetExpires(expires)
+			changed = true
+			renewed = true
+		}
+	}
+
+	if !changed {
+		return s, false, nil
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	updated.Edges = s.Edges
+
+	return updated, renewed, nil
+}
+
+// ListActiveSessions returns the user's sessions that have neither idled out nor
+// passed their absolute lifetime, newest first
+func ListActiveSessions(ctx context.Context, u *ent.User) ([]*ent.Session, error) {
+	now := time.Now()
+
+	return database.DB.Session.Query().
+		Where(
+			session.HasUserWith(user.IDEQ(u.ID)),
+			session.ExpiresGT(now),
+			session.CreatedAtGT(now.Add(-config.GetSessionAbsoluteLifetime())),
+		).
+		Order(ent.Desc(session.FieldCreatedAt)).
+		All(ctx)
+}
+
+// RevokeSession deletes a single session, provided it belongs to the user
+func RevokeSession(ctx context.Context, u *ent.User, sessionID guuid.UUID) error {
+	deleted, err := database.DB.Session.Delete().
+		Where(
+			session.ID(sessionID),
+			session.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fiber.NewError(fiber.StatusNotFound, "Session not found")
+	}
+	return nil
+}
+
+// RevokeOtherSessions deletes every session of the user except the given one
+// and returns how many were removed
+func RevokeOtherSessions(ctx context.Context, u *ent.User, currentID guuid.UUID) (int, error) {
+	return database.DB.Session.Delete().
+		Where(
+			session.HasUserWith(user.IDEQ(u.ID)),
+			session.IDNEQ(currentID),
+		).
+		Exec(ctx)
+}
+
+// SetSessionCookie writes the session token cookie with an expiry matching the session
+func SetSessionCookie(c *fiber.Ctx, token string, s *ent.Session) {
+	c.Cookie(&fiber.Cookie{
+		Name:     "session",
+		Value:    token,
+		Expires:  s.Expires,
+		HTTPOnly: true,
+		Secure:   config.GetIsProduction(),
+		SameSite: "Lax",
+	})
 }
@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/ent"
+	"github.com/NikSchaefer/go-fiber/ent/predicate"
+	"github.com/NikSchaefer/go-fiber/ent/refreshtoken"
+	"github.com/NikSchaefer/go-fiber/ent/tokenfamily"
+	"github.com/NikSchaefer/go-fiber/ent/user"
+	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
+	"github.com/NikSchaefer/go-fiber/pkg/utils"
+	"github.com/gofiber/fiber/v2"
+	guuid "github.com/google/uuid"
+)
+
+// refreshTokenBytes is the entropy of a refresh token handed to the client
+const refreshTokenBytes = 32
+
+// TokenPair is returned to bearer token clients on login and refresh
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int    `json:"expiresIn"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// IssueTokenPair starts a new token family for the user and returns its first token pair
+func IssueTokenPair(ctx context.Context, u *ent.User) (*TokenPair, error) {
+	family, err := database.DB.TokenFamily.Create().
+		SetUser(u).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return issueTokenPairInFamily(ctx, u, family)
+}
+
+// issueTokenPairInFamily persists a new refresh token in the family and signs an access token bound to it
+func issueTokenPairInFamily(ctx context.Context, u *ent.User, family *ent.TokenFamily) (*TokenPair, error) {
+	refreshToken, err := utils.GenerateToken(refreshTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = database.DB.RefreshToken.Create().
+		SetUser(u).
+		SetFamily(family).
+		SetTokenHash(utils.HashToken(refreshToken)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := tokens.IssueAccessToken(u.ID, family.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(time.Until(expiresAt).Seconds()),
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RotateRefreshToken exchanges a refresh token for a new token pair in the same family.
+// A token that was already rotated being presented again means it leaked, so the
+// whole family is revoked and a security event is recorded.
+func RotateRefreshToken(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	db := database.DB
+
+	rt, err := db.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(utils.HashToken(refreshToken))).
+		WithUser().
+		WithFamily().
+		Only(ctx)
+	if err != nil {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid refresh token")
+	}
+
+	family := rt.Edges.Family
+	if family.RevokedAt != nil || rt.RevokedAt != nil || time.Now().After(rt.ExpiresAt) {
+		return nil, fiber.NewError(fiber.StatusUnauthorized, "Refresh token expired or revoked")
+	}
+
+	if rt.RotatedAt != nil {
+		return nil, handleRefreshTokenReuse(ctx, rt)
+	}
+
+	// Mark as rotated only if nobody else did so concurrently
+	rotated, err := db.RefreshToken.Update().
+		Where(
+			refreshtoken.ID(rt.ID),
+			refreshtoken.RotatedAtIsNil(),
+		).
+		SetRotatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if rotated == 0 {
+		return nil, handleRefreshTokenReuse(ctx, rt)
+	}
+
+	return issueTokenPairInFamily(ctx, rt.Edges.User, family)
+}
+
+// handleRefreshTokenReuse revokes the family of a replayed refresh token and records the event
+func handleRefreshTokenReuse(ctx context.Context, rt *ent.RefreshToken) error {
+	err := RevokeTokenFamily(ctx, rt.Edges.Family.ID, tokenfamily.RevokeReasonReuseDetected)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("security: refresh token reuse detected for user %s, family %s revoked", rt.Edges.User.ID, rt.Edges.Family.ID)
+	analytics.TrackEventWithUser("refresh_token_reuse_detected", map[string]interface{}{
+		"family": rt.Edges.Family.ID,
+	}, rt.Edges.User)
+
+	return fiber.NewError(fiber.StatusUnauthorized, "Refresh token reuse detected, please log in again")
+}
+
+// RevokeTokenFamily revokes a token family and every refresh token in it
+func RevokeTokenFamily(ctx context.Context, familyID guuid.UUID, reason tokenfamily.RevokeReason) error {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	err = tx.TokenFamily.Update().
+		Where(
+			tokenfamily.ID(familyID),
+			tokenfamily.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		SetRevokeReason(reason).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	err = tx.RefreshToken.Update().
+		Where(
+			refreshtoken.HasFamilyWith(tokenfamily.ID(familyID)),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// RevokeOtherTokenFamilies revokes every active token family of the user except
+// the given one, pass uuid.Nil to revoke them all
+func RevokeOtherTokenFamilies(ctx context.Context, u *ent.User, currentID guuid.UUID, reason tokenfamily.RevokeReason) error {
+	tx, err := database.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+
+	others := []predicate.TokenFamily{
+		tokenfamily.HasUserWith(user.IDEQ(u.ID)),
+		tokenfamily.IDNEQ(currentID),
+		tokenfamily.RevokedAtIsNil(),
+	}
+
+	err = tx.RefreshToken.Update().
+		Where(
+			refreshtoken.HasFamilyWith(others...),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	err = tx.TokenFamily.Update().
+		Where(others...).
+		SetRevokedAt(now).
+		SetRevokeReason(reason).
+		Exec(ctx)
+	if err != nil {
+		return utils.RollbackTx(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// RevokeRefreshToken revokes the family of a refresh token by its raw value
+func RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	rt, err := database.DB.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(utils.HashToken(refreshToken))).
+		WithFamily().
+		Only(ctx)
+	if err != nil {
+		return fiber.NewError(fiber.StatusUnauthorized, "Invalid refresh token")
+	}
+
+	return RevokeTokenFamily(ctx, rt.Edges.Family.ID, tokenfamily.RevokeReasonLogout)
+}
+
+// RevokeUserTokenFamily revokes the token family an access token was issued for
+func RevokeUserTokenFamily(ctx context.Context, u *ent.User, familyID guuid.UUID) error {
+	exists, err := database.DB.TokenFamily.Query().
+		Where(
+			tokenfamily.ID(familyID),
+			tokenfamily.HasUserWith(user.IDEQ(u.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fiber.NewError(fiber.StatusNotFound, "Token family not found")
+	}
+
+	return RevokeTokenFamily(ctx, familyID, tokenfamily.RevokeReasonLogout)
+}
+
+// ValidateAccessToken verifies a bearer access token and returns the user it was issued to.
+// Tokens of a revoked family are rejected even before they expire.
+func ValidateAccessToken(ctx context.Context, accessToken string) (*ent.User, *tokens.AccessClaims, error) {
+	claims, err := tokens.ParseAccessToken(accessToken)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token")
+	}
+
+	userID, err := guuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token")
+	}
+	familyID, err := guuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token")
+	}
+
+	family, err := database.DB.TokenFamily.Query().
+		Where(
+			tokenfamily.ID(familyID),
+			tokenfamily.HasUserWith(user.IDEQ(userID)),
+		).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Invalid access token user")
+	}
+	if family.RevokedAt != nil {
+		return nil, nil, fiber.NewError(fiber.StatusUnauthorized, "Access token revoked")
+	}
+
+	return family.Edges.User, claims, nil
+}
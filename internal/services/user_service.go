@@ -14,7 +14,7 @@ import (
 type CreateUserStruct struct {
 	Name                   string     `validate:"required,min=2,max=100"`
 	Email                  string     `validate:"required,email"`
-	Password               *string    `validate:"omitempty,min=8"`
+	Password               *string    `validate:"omitempty"`
 	Phone                  *string    `validate:"omitempty,e164"`
 }
 
@@ -26,7 +26,7 @@ func CreateUserWithTx(ctx context.Context, tx *ent.Tx, data CreateUserStruct) (*
 
 	var pw []byte
 	if data.Password != nil {
-		pw, err = utils.SaltAndVerifyPassword(*data.Password)
+		pw, err = HashNewPassword(ctx, *data.Password, data.Email, data.Name)
 		if err != nil {
 			return nil, err
 		}
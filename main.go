@@ -5,6 +5,7 @@ import (
 
 	"github.com/NikSchaefer/go-fiber/config"
 	"github.com/NikSchaefer/go-fiber/internal/database"
+	"github.com/NikSchaefer/go-fiber/internal/middleware"
 	"github.com/NikSchaefer/go-fiber/internal/router"
 	util "github.com/NikSchaefer/go-fiber/pkg"
 	"github.com/joho/godotenv"
@@ -19,13 +20,15 @@ func InitializeApp() *fiber.App {
 
 	util.InitializeServices()
 
-	app := *fiber.New()
+	app := *fiber.New(fiber.Config{
+		ErrorHandler: middleware.ErrorHandler,
+	})
 
 	app.Use(logger.New())
 
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     config.GetAllowedOrigins(), // replace with your domain (e.g. google.com)
-		AllowHeaders:     "Origin, Content-Type, Accept, Cookie",
+		AllowHeaders:     "Origin, Content-Type, Accept, Cookie, Authorization, X-Auth-Mode, X-API-Key",
 		AllowCredentials: true,
 		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS,PATCH",
 	}))
@@ -0,0 +1,96 @@
+// Package imaging turns user uploaded images into safe, normalized thumbnails
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http"
+
+	// Register the decoders of the accepted formats
+	_ "image/gif"
+	_ "image/png"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format, use JPEG, PNG, GIF or WebP")
+	ErrTooManyPixels     = errors.New("image dimensions are too large")
+)
+
+// allowedTypes are the formats accepted, by their sniffed content type
+var allowedTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// DetectContentType sniffs the format from the data itself, never trusting the
+// declared content type or file name
+func DetectContentType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if !allowedTypes[contentType] {
+		return "", ErrUnsupportedFormat
+	}
+	return contentType, nil
+}
+
+// Decode reads an image of an accepted format. The dimensions are checked
+// before decoding, so a small file can't expand into a huge bitmap.
+func Decode(data []byte, maxPixels int) (image.Image, error) {
+	if _, err := DetectContentType(data); err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	return img, nil
+}
+
+// SquareThumbnail center crops the image to a square and scales it to size
+// pixels. orientation is the EXIF orientation of the source, see Orientation.
+func SquareThumbnail(img image.Image, size int, orientation int) image.Image {
+	bounds := img.Bounds()
+	edge := bounds.Dx()
+	if bounds.Dy() < edge {
+		edge = bounds.Dy()
+	}
+	crop := image.Rect(0, 0, edge, edge).Add(image.Pt(
+		bounds.Min.X+(bounds.Dx()-edge)/2,
+		bounds.Min.Y+(bounds.Dy()-edge)/2,
+	))
+
+	// Transparent areas end up white once encoded as JPEG
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Over, nil)
+
+	// A centered square crop looks the same rotated or flipped, so orienting
+	// the thumbnail is equivalent to orienting the source and a lot cheaper
+	return orient(dst, orientation)
+}
+
+// EncodeJPEG encodes the image from its pixels alone, which drops EXIF and any
+// other metadata the upload carried
+func EncodeJPEG(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,171 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// solid returns a w by h image filled with c
+func solid(w, h int, c color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeTestJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeader is a PNG that only declares its dimensions, without any pixel data
+func pngHeader(width, height uint32) []byte {
+	ihdr := make([]byte, 13)
+	binary.BigEndian.PutUint32(ihdr[0:], width)
+	binary.BigEndian.PutUint32(ihdr[4:], height)
+	ihdr[8] = 8 // bit depth
+	ihdr[9] = 6 // RGBA
+
+	data := []byte("\x89PNG\r\n\x1a\n")
+	data = binary.BigEndian.AppendUint32(data, uint32(len(ihdr)))
+	chunk := append([]byte("IHDR"), ihdr...)
+	data = append(data, chunk...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+}
+
+func TestDetectContentType(t *testing.T) {
+	var gifData bytes.Buffer
+	if err := gif.Encode(&gifData, solid(2, 2, color.White), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{name: "png", data: encodePNG(t, solid(2, 2, color.White)), want: "image/png"},
+		{name: "jpeg", data: encodeTestJPEG(t, solid(2, 2, color.White)), want: "image/jpeg"},
+		{name: "gif", data: gifData.Bytes(), want: "image/gif"},
+		{name: "webp", data: []byte("RIFF\x1a\x00\x00\x00WEBPVP8 \x0e\x00\x00\x00"), want: "image/webp"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectContentType(tt.data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("content type = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectContentTypeRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "svg", data: []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`)},
+		{name: "html", data: []byte("<!DOCTYPE html><html><body></body></html>")},
+		{name: "bmp", data: []byte("BM\x3e\x00\x00\x00\x00\x00\x00\x00\x36\x00\x00\x00")},
+		{name: "pdf", data: []byte("%PDF-1.7\n")},
+		{name: "empty", data: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DetectContentType(tt.data); !errors.Is(err, ErrUnsupportedFormat) {
+				t.Errorf("err = %v, want ErrUnsupportedFormat", err)
+			}
+		})
+	}
+}
+
+func TestDecodeChecksPixelsBeforeDecoding(t *testing.T) {
+	// Declares 100k by 100k pixels in a few bytes, decoding it would allocate 40GB
+	_, err := Decode(pngHeader(100000, 100000), 4096*4096)
+	if !errors.Is(err, ErrTooManyPixels) {
+		t.Fatalf("err = %v, want ErrTooManyPixels", err)
+	}
+}
+
+func TestDecodePixelLimit(t *testing.T) {
+	data := encodePNG(t, solid(10, 10, color.White))
+
+	if _, err := Decode(data, 100); err != nil {
+		t.Fatalf("image at the limit: %v", err)
+	}
+	if _, err := Decode(data, 99); !errors.Is(err, ErrTooManyPixels) {
+		t.Fatalf("image over the limit: err = %v, want ErrTooManyPixels", err)
+	}
+}
+
+func TestDecodeRejectsCorruptData(t *testing.T) {
+	data := encodePNG(t, solid(10, 10, color.White))
+
+	if _, err := Decode(data[:len(data)/2], 1000); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Fatalf("truncated image: err = %v, want ErrUnsupportedFormat", err)
+	}
+	if _, err := Decode(pngHeader(0, 10), 1000); err == nil {
+		t.Fatal("image without width decoded")
+	}
+}
+
+func TestSquareThumbnailCropsToCenter(t *testing.T) {
+	// Red bars left and right of a blue square, only the square should remain
+	img := solid(30, 10, color.RGBA{R: 255, A: 255})
+	for y := 0; y < 10; y++ {
+		for x := 10; x < 20; x++ {
+			img.Set(x, y, color.RGBA{B: 255, A: 255})
+		}
+	}
+
+	thumb := SquareThumbnail(img, 8, 1)
+	if b := thumb.Bounds(); b.Dx() != 8 || b.Dy() != 8 {
+		t.Fatalf("bounds = %v, want 8x8", b)
+	}
+	for _, p := range []image.Point{{0, 0}, {7, 0}, {0, 7}, {7, 7}, {4, 4}} {
+		r, _, b, _ := thumb.At(p.X, p.Y).RGBA()
+		if r > 0x2000 || b < 0xe000 {
+			t.Errorf("pixel %v = %v, want blue", p, thumb.At(p.X, p.Y))
+		}
+	}
+}
+
+func TestSquareThumbnailFlattensTransparency(t *testing.T) {
+	thumb := SquareThumbnail(solid(4, 4, color.Transparent), 4, 1)
+
+	r, g, b, a := thumb.At(2, 2).RGBA()
+	if r != 0xffff || g != 0xffff || b != 0xffff || a != 0xffff {
+		t.Errorf("transparent pixel = %v, want white", thumb.At(2, 2))
+	}
+}
@@ -0,0 +1,152 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// withExif inserts an APP1 segment recording orientation right after the SOI
+// marker of a JPEG, the way cameras write it
+func withExif(jpegData []byte, order binary.AppendByteOrder, orientation uint16) []byte {
+	tiff := []byte("II*\x00")
+	if order == binary.BigEndian {
+		tiff = []byte("MM\x00*")
+	}
+	tiff = order.AppendUint32(tiff, 8) // first IFD right after the header
+	tiff = order.AppendUint16(tiff, 1) // one entry
+	tiff = order.AppendUint16(tiff, exifOrientationTag)
+	tiff = order.AppendUint16(tiff, 3) // SHORT
+	tiff = order.AppendUint32(tiff, 1)
+	tiff = order.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0)          // value padding
+	tiff = order.AppendUint32(tiff, 0) // no next IFD
+
+	segment := append([]byte("Exif\x00\x00"), tiff...)
+	app1 := []byte{0xFF, 0xE1}
+	app1 = binary.BigEndian.AppendUint16(app1, uint16(len(segment)+2))
+	app1 = append(app1, segment...)
+
+	data := append([]byte{}, jpegData[:2]...)
+	data = append(data, app1...)
+	return append(data, jpegData[2:]...)
+}
+
+func TestOrientation(t *testing.T) {
+	plain := encodeTestJPEG(t, solid(4, 4, color.White))
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{name: "little endian", data: withExif(plain, binary.LittleEndian, 6), want: 6},
+		{name: "big endian", data: withExif(plain, binary.BigEndian, 8), want: 8},
+		{name: "upright", data: withExif(plain, binary.LittleEndian, 1), want: 1},
+		{name: "out of range", data: withExif(plain, binary.LittleEndian, 9), want: 1},
+		{name: "no exif", data: plain, want: 1},
+		{name: "png", data: encodePNG(t, solid(4, 4, color.White)), want: 1},
+		{name: "truncated segment", data: withExif(plain, binary.LittleEndian, 6)[:12], want: 1},
+		{name: "empty", data: nil, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Orientation(tt.data); got != tt.want {
+				t.Errorf("orientation = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSquareThumbnailAppliesOrientation(t *testing.T) {
+	var (
+		red   = color.RGBA{R: 255, A: 255}
+		green = color.RGBA{G: 255, A: 255}
+		blue  = color.RGBA{B: 255, A: 255}
+	)
+
+	// As stored: red top left, green top right, blue below
+	stored := solid(16, 16, blue)
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			stored.Set(x, y, red)
+			stored.Set(x+8, y, green)
+		}
+	}
+
+	topLeft, topRight := image.Pt(3, 3), image.Pt(12, 3)
+	bottomLeft, bottomRight := image.Pt(3, 12), image.Pt(12, 12)
+
+	// Where the stored top left and top right corners are once displayed upright
+	tests := []struct {
+		orientation int
+		red, green  image.Point
+	}{
+		{orientation: 1, red: topLeft, green: topRight},
+		{orientation: 2, red: topRight, green: topLeft},
+		{orientation: 3, red: bottomRight, green: bottomLeft},
+		{orientation: 4, red: bottomLeft, green: bottomRight},
+		{orientation: 5, red: topLeft, green: bottomLeft},
+		{orientation: 6, red: topRight, green: bottomRight},
+		{orientation: 7, red: bottomRight, green: topRight},
+		{orientation: 8, red: bottomLeft, green: topLeft},
+	}
+
+	for _, tt := range tests {
+		thumb := SquareThumbnail(stored, 16, tt.orientation)
+
+		for _, want := range []struct {
+			at    image.Point
+			color color.RGBA
+		}{{tt.red, red}, {tt.green, green}} {
+			r, g, b, _ := thumb.At(want.at.X, want.at.Y).RGBA()
+			got := color.RGBA{R: uint8(r >> 8), G: uint8(g >> 8), B: uint8(b >> 8), A: 255}
+			if !closeColor(got, want.color) {
+				t.Errorf("orientation %d: pixel %v = %v, want %v", tt.orientation, want.at, got, want.color)
+			}
+		}
+	}
+}
+
+func closeColor(a, b color.RGBA) bool {
+	near := func(x, y uint8) bool {
+		d := int(x) - int(y)
+		return d > -16 && d < 16
+	}
+	return near(a.R, b.R) && near(a.G, b.G) && near(a.B, b.B)
+}
+
+func TestEncodeJPEGStripsMetadata(t *testing.T) {
+	source := withExif(encodeTestJPEG(t, solid(16, 16, color.White)), binary.LittleEndian, 6)
+	// A comment segment, standing in for any other metadata an upload carries
+	source = append(source[:2], append([]byte("\xFF\xFE\x00\x0Fcamera-secret"), source[2:]...)...)
+
+	if got := Orientation(source); got != 6 {
+		t.Fatalf("source orientation = %d, want 6", got)
+	}
+
+	img, err := Decode(source, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := EncodeJPEG(SquareThumbnail(img, 8, Orientation(source)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(out, []byte("Exif\x00\x00")) {
+		t.Error("EXIF segment kept")
+	}
+	if bytes.Contains(out, []byte("camera-secret")) {
+		t.Error("comment segment kept")
+	}
+	if got := Orientation(out); got != 1 {
+		t.Errorf("orientation = %d, want the thumbnail stored upright", got)
+	}
+	if _, err := DetectContentType(out); err != nil {
+		t.Errorf("thumbnail is not a JPEG: %v", err)
+	}
+}
@@ -3,6 +3,10 @@ package pkg
 import (
 	"github.com/NikSchaefer/go-fiber/internal/database"
 	"github.com/NikSchaefer/go-fiber/pkg/analytics"
+	"github.com/NikSchaefer/go-fiber/pkg/notifications"
+	"github.com/NikSchaefer/go-fiber/pkg/password"
+	"github.com/NikSchaefer/go-fiber/pkg/storage"
+	"github.com/NikSchaefer/go-fiber/pkg/tokens"
 	"github.com/NikSchaefer/go-fiber/pkg/validator"
 )
 
@@ -13,6 +17,10 @@ const autoMigrate = true
 func InitializeServices() {
 	analytics.InitAnalytics()
 	validator.InitializeValidator()
+	tokens.InitKeys()
+	notifications.InitService()
+	storage.InitStorage()
+	password.InitPolicy()
 
 
 	database.InitializeDB(autoMigrate)
@@ -0,0 +1,167 @@
+// Package jwks fetches and caches the JSON Web Key Sets identity providers
+// publish for verifying the ID tokens they sign.
+package jwks
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// defaultTTL is used when the response has no usable Cache-Control max-age
+	defaultTTL = time.Hour
+	// minRefreshInterval bounds refetches triggered by unknown key IDs, so
+	// tokens with made up kids can't be used to hammer the provider
+	minRefreshInterval = time.Minute
+)
+
+var maxAgePattern = regexp.MustCompile(`max-age=(\d+)`)
+
+// ErrKeyNotFound is returned when no key in the set matches the token's kid
+var ErrKeyNotFound = errors.New("jwks: signing key not found")
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// Cache holds the RSA keys of one JWKS URL, refetching them when they expire
+// or a token references a key that isn't known yet
+type Cache struct {
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expiresAt time.Time
+	fetchedAt time.Time
+}
+
+// New creates a cache for the key set at url. Keys are fetched on first use.
+func New(url string) *Cache {
+	return &Cache{
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Key returns the public key with the given ID
+func (c *Cache) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	key, ok := c.keys[kid]
+	if ok && now.Before(c.expiresAt) {
+		return key, nil
+	}
+
+	// Refresh when expired, or when the kid is unknown since providers rotate keys
+	if now.After(c.expiresAt) || now.Sub(c.fetchedAt) >= minRefreshInterval {
+		if err := c.refresh(ctx, now); err != nil {
+			if ok {
+				// Keep verifying with the stale set while the provider is unreachable
+				return key, nil
+			}
+			return nil, err
+		}
+		key, ok = c.keys[kid]
+	}
+
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+// Keyfunc looks up the verification key for jwt.Parse by the token's kid header
+func (c *Cache) Keyfunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("jwks: token has no kid")
+		}
+		return c.Key(ctx, kid)
+	}
+}
+
+func (c *Cache) refresh(ctx context.Context, now time.Time) error {
+	c.fetchedAt = now
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("jwks: fetching %s returned %d", c.url, resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("jwks: decoding %s: %w", c.url, err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" || k.Kid == "" {
+			continue
+		}
+		key, err := parseRSAKey(k)
+		if err != nil {
+			return fmt.Errorf("jwks: key %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+
+	ttl := defaultTTL
+	if match := maxAgePattern.FindStringSubmatch(resp.Header.Get("Cache-Control")); match != nil {
+		if seconds, err := strconv.Atoi(match[1]); err == nil && seconds > 0 {
+			ttl = time.Duration(seconds) * time.Second
+		}
+	}
+
+	c.keys = keys
+	c.expiresAt = now.Add(ttl)
+	return nil
+}
+
+func parseRSAKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent too large")
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(exponent.Int64()),
+	}, nil
+}
@@ -0,0 +1,173 @@
+package jwks
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// keyServer publishes a JWKS that can be rotated or taken down, counting fetches
+type keyServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	keys    map[string]*rsa.PublicKey
+	down    bool
+	fetches int
+}
+
+func newKeyServer(t *testing.T, kids ...string) *keyServer {
+	t.Helper()
+
+	s := &keyServer{keys: make(map[string]*rsa.PublicKey)}
+	for _, kid := range kids {
+		s.add(t, kid)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.fetches++
+		if s.down {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var set struct {
+			Keys []jsonWebKey `json:"keys"`
+		}
+		for kid, key := range s.keys {
+			set.Keys = append(set.Keys, jsonWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		w.Header().Set("Cache-Control", "public, max-age=600")
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *keyServer) add(t *testing.T, kid string) *rsa.PublicKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[kid] = &key.PublicKey
+	return &key.PublicKey
+}
+
+func (s *keyServer) setDown(down bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.down = down
+}
+
+func (s *keyServer) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+func TestKeyIsFetchedOnceAndCached(t *testing.T) {
+	server := newKeyServer(t, "k1")
+	cache := New(server.URL)
+
+	for i := 0; i < 3; i++ {
+		key, err := cache.Key(context.Background(), "k1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if key.N.Cmp(server.keys["k1"].N) != 0 {
+			t.Fatal("returned a different key")
+		}
+	}
+
+	if n := server.fetchCount(); n != 1 {
+		t.Errorf("fetches = %d, want 1", n)
+	}
+	if ttl := time.Until(cache.expiresAt); ttl < 9*time.Minute || ttl > 10*time.Minute {
+		t.Errorf("cache lifetime = %v, want the 600s max-age", ttl)
+	}
+}
+
+func TestUnknownKidTriggersRefetch(t *testing.T) {
+	server := newKeyServer(t, "k1")
+	cache := New(server.URL)
+
+	if _, err := cache.Key(context.Background(), "k1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The provider rotates in a new key after the set was cached
+	rotated := server.add(t, "k2")
+	cache.fetchedAt = time.Now().Add(-minRefreshInterval)
+
+	key, err := cache.Key(context.Background(), "k2")
+	if err != nil {
+		t.Fatalf("rotated key: %v", err)
+	}
+	if key.N.Cmp(rotated.N) != 0 {
+		t.Fatal("returned a different key")
+	}
+	if n := server.fetchCount(); n != 2 {
+		t.Errorf("fetches = %d, want 2", n)
+	}
+}
+
+func TestUnknownKidRefetchIsRateLimited(t *testing.T) {
+	server := newKeyServer(t, "k1")
+	cache := New(server.URL)
+
+	if _, err := cache.Key(context.Background(), "k1"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := cache.Key(context.Background(), "made-up"); !errors.Is(err, ErrKeyNotFound) {
+			t.Fatalf("err = %v, want ErrKeyNotFound", err)
+		}
+	}
+
+	if n := server.fetchCount(); n != 1 {
+		t.Errorf("fetches = %d, want 1 within the minimum refresh interval", n)
+	}
+}
+
+func TestStaleKeysAreUsedWhileProviderIsDown(t *testing.T) {
+	server := newKeyServer(t, "k1")
+	cache := New(server.URL)
+
+	if _, err := cache.Key(context.Background(), "k1"); err != nil {
+		t.Fatal(err)
+	}
+
+	server.setDown(true)
+	cache.expiresAt = time.Now().Add(-time.Second)
+
+	if _, err := cache.Key(context.Background(), "k1"); err != nil {
+		t.Fatalf("known key while the provider is down: %v", err)
+	}
+	if _, err := cache.Key(context.Background(), "k2"); err == nil {
+		t.Fatal("unknown key resolved while the provider is down")
+	}
+}
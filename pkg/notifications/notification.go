@@ -49,6 +49,7 @@ func InitService() {
 	service = &NotificationService{
 		templates:   templates.Templates,
 		emailSender: NewEmailSender(),
+		smsSender:   NewSMSSender(),
 	}
 }
 
@@ -0,0 +1,52 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/matcornic/hermes"
+)
+
+// AccountLinkedTemplateData is sent whenever a new sign-in method is added to an account
+type AccountLinkedTemplateData struct {
+	Provider string
+	Name     string
+}
+
+// Validate implements TemplateData interface for AccountLinkedTemplateData
+func (d *AccountLinkedTemplateData) Validate() error {
+	if d.Provider == "" {
+		return fmt.Errorf("provider cannot be empty")
+	}
+	return nil
+}
+
+var AccountLinkedTemplate = Template{
+	ID: "account_linked",
+	Email: func(data TemplateData) (EmailTemplateData, error) {
+		linkedData, ok := data.(*AccountLinkedTemplateData)
+		if !ok {
+			return EmailTemplateData{}, fmt.Errorf("invalid template data type")
+		}
+
+		return EmailTemplateData{
+			Subject: "A new sign-in method was added to your account",
+			Name:    linkedData.Name,
+			Intros: []string{
+				fmt.Sprintf("You can now log in to your account with %s.", linkedData.Provider),
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "If this wasn't you, review the sign-in methods on your account and remove it:",
+					Button: hermes.Button{
+						Text: "Review Sign-in Methods",
+						Link: fmt.Sprintf("%s/settings/accounts", config.GetURL()),
+					},
+				},
+			},
+		}, nil
+	},
+	SMS: func(data TemplateData) (SMSTemplateData, error) {
+		return SMSTemplateData{}, fmt.Errorf("sms notifications not supported for linked accounts")
+	},
+}
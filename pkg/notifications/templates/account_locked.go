@@ -0,0 +1,55 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/matcornic/hermes"
+)
+
+// AccountLockedTemplateData is sent when repeated failed logins lock an account
+type AccountLockedTemplateData struct {
+	UnlockToken string
+	Name        string
+}
+
+// Validate implements TemplateData interface for AccountLockedTemplateData
+func (d *AccountLockedTemplateData) Validate() error {
+	if d.UnlockToken == "" {
+		return fmt.Errorf("unlock token cannot be empty")
+	}
+	return nil
+}
+
+var AccountLockedTemplate = Template{
+	ID: "account_locked",
+	Email: func(data TemplateData) (EmailTemplateData, error) {
+		lockedData, ok := data.(*AccountLockedTemplateData)
+		if !ok {
+			return EmailTemplateData{}, fmt.Errorf("invalid template data type")
+		}
+
+		return EmailTemplateData{
+			Subject: "Your account has been temporarily locked",
+			Name:    lockedData.Name,
+			Intros: []string{
+				"We noticed several failed attempts to log in to your account, so logging in has been temporarily disabled.",
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "If this was you, click the button below to unlock your account right away:",
+					Button: hermes.Button{
+						Text: "Unlock Account",
+						Link: fmt.Sprintf("%s/login/unlock?token=%s", config.GetURL(), lockedData.UnlockToken),
+					},
+				},
+			},
+			Outros: []string{
+				"If this wasn't you, someone may be trying to guess your password. Consider changing it once you are logged in.",
+			},
+		}, nil
+	},
+	SMS: func(data TemplateData) (SMSTemplateData, error) {
+		return SMSTemplateData{}, fmt.Errorf("sms notifications not supported for account lockout")
+	},
+}
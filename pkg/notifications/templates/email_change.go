@@ -0,0 +1,60 @@
+package templates
+
+import (
+	"fmt"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/matcornic/hermes"
+)
+
+// EmailChangeTemplateData is sent to the old address when a change to a new one is requested
+type EmailChangeTemplateData struct {
+	RevertToken string
+	NewEmail    string
+	Name        string
+}
+
+// Validate implements TemplateData interface for EmailChangeTemplateData
+func (d *EmailChangeTemplateData) Validate() error {
+	if d.RevertToken == "" {
+		return fmt.Errorf("revert token cannot be empty")
+	}
+	if d.NewEmail == "" {
+		return fmt.Errorf("new email cannot be empty")
+	}
+	return nil
+}
+
+var EmailChangeTemplate = Template{
+	ID: "email_change",
+	Email: func(data TemplateData) (EmailTemplateData, error) {
+		changeData, ok := data.(*EmailChangeTemplateData)
+		if !ok {
+			return EmailTemplateData{}, fmt.Errorf("invalid template data type")
+		}
+
+		return EmailTemplateData{
+			Subject: "Your email address is being changed",
+			Name:    changeData.Name,
+			Intros: []string{
+				fmt.Sprintf("A request was made to change the email address of your account to %s. The change takes effect once it is confirmed from the new address.", changeData.NewEmail),
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "If you didn't request this, click the button below to cancel the change, or undo it if it was already confirmed. This will log out every device.",
+					Button: hermes.Button{
+						Color: "#DC4D2F",
+						Text:  "This Wasn't Me",
+						Link:  fmt.Sprintf("%s/email/revert?token=%s", config.GetURL(), changeData.RevertToken),
+					},
+				},
+			},
+			Outros: []string{
+				"If you made this change, you can ignore this email.",
+			},
+		}, nil
+	},
+	SMS: func(data TemplateData) (SMSTemplateData, error) {
+		return SMSTemplateData{}, fmt.Errorf("sms notifications not supported for email changes")
+	},
+}
@@ -0,0 +1,64 @@
+package templates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/matcornic/hermes"
+)
+
+// MagicLinkTemplateData is sent for passwordless logins by email link
+type MagicLinkTemplateData struct {
+	Token string
+	Name  string
+	// RequestedFrom describes the device that asked for the link, e.g. "Chrome on macOS (203.0.113.7)"
+	RequestedFrom string
+	ValidFor      time.Duration
+}
+
+// Validate implements TemplateData interface for MagicLinkTemplateData
+func (d *MagicLinkTemplateData) Validate() error {
+	if d.Token == "" {
+		return fmt.Errorf("link token cannot be empty")
+	}
+	return nil
+}
+
+var MagicLinkTemplate = Template{
+	ID: "magic_link",
+	Email: func(data TemplateData) (EmailTemplateData, error) {
+		linkData, ok := data.(*MagicLinkTemplateData)
+		if !ok {
+			return EmailTemplateData{}, fmt.Errorf("invalid template data type")
+		}
+
+		intros := []string{
+			fmt.Sprintf("Use the link below to log in. It can be used once and expires in %d minutes.", int(linkData.ValidFor.Minutes())),
+		}
+		if linkData.RequestedFrom != "" {
+			intros = append(intros, "This link was requested from "+linkData.RequestedFrom+".")
+		}
+
+		return EmailTemplateData{
+			Subject: "Your login link",
+			Name:    linkData.Name,
+			Intros:  intros,
+			Actions: []hermes.Action{
+				{
+					Instructions: "Click the button below to log in:",
+					Button: hermes.Button{
+						Text: "Log In",
+						Link: fmt.Sprintf("%s/login/magic?token=%s", config.GetURL(), linkData.Token),
+					},
+				},
+			},
+			Outros: []string{
+				"If you did not request this link, please ignore this email. Nobody can log in without clicking it.",
+			},
+		}, nil
+	},
+	SMS: func(data TemplateData) (SMSTemplateData, error) {
+		return SMSTemplateData{}, fmt.Errorf("sms notifications not supported for login links")
+	},
+}
@@ -30,4 +30,8 @@ type TemplateData interface {
 var Templates = map[string]Template{
 	"otp":                          OTPTemplate,
 	"reset_password":               ResetPasswordTemplate,
+	"account_locked":               AccountLockedTemplate,
+	"magic_link":                   MagicLinkTemplate,
+	"email_change":                 EmailChangeTemplate,
+	"account_linked":               AccountLinkedTemplate,
 }
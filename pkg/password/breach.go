@@ -0,0 +1,65 @@
+package password
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// RangeSource returns the breached password hashes starting with a 5 character
+// SHA-1 prefix, one "SUFFIX:COUNT" line each. Only the prefix ever leaves the
+// caller, so a remote source learns nothing about the password checked.
+type RangeSource interface {
+	Range(ctx context.Context, prefix string) ([]byte, error)
+}
+
+type prefixDirSource struct {
+	dir string
+}
+
+// NewPrefixDirSource reads ranges from files named by their prefix, e.g.
+// 21BD1.txt, as written by the Have I Been Pwned downloader
+func NewPrefixDirSource(dir string) RangeSource {
+	return &prefixDirSource{dir: dir}
+}
+
+func (s *prefixDirSource) Range(ctx context.Context, prefix string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// BreachCount returns how often the password appears in the source's breaches
+func BreachCount(ctx context.Context, source RangeSource, password string) (int, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	data, err := source.Range(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, err
+		}
+		return n, nil
+	}
+	return 0, scanner.Err()
+}
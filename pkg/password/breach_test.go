@@ -0,0 +1,67 @@
+package password
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// hashRange splits the password's SHA-1 into the range prefix and the suffix
+// listed in that range
+func hashRange(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:5], hash[5:]
+}
+
+// writeRange writes a range file the way the Have I Been Pwned downloader does
+func writeRange(t *testing.T, dir, prefix string, lines ...string) {
+	t.Helper()
+
+	data := strings.Join(lines, "\r\n")
+	if err := os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBreachCount(t *testing.T) {
+	dir := t.TempDir()
+
+	prefix, suffix := hashRange("hunter2")
+	writeRange(t, dir, prefix,
+		"0018A45C4D1DEF81644B54AB7F969B88D65:1",
+		suffix+":17",
+		"00D4F6E8FA6EECAD2A3AA415EEC418D38EC:2",
+	)
+	prefix, suffix = hashRange("correct horse")
+	writeRange(t, dir, prefix, strings.ToLower(suffix)+":3")
+	prefix, _ = hashRange("tr0ub4dor")
+	writeRange(t, dir, prefix, "0018A45C4D1DEF81644B54AB7F969B88D65:1")
+
+	tests := []struct {
+		name     string
+		password string
+		want     int
+	}{
+		{name: "hit", password: "hunter2", want: 17},
+		{name: "lowercase suffix", password: "correct horse", want: 3},
+		{name: "miss in the prefix file", password: "tr0ub4dor", want: 0},
+		{name: "no prefix file", password: "never seen before", want: 0},
+	}
+
+	source := NewPrefixDirSource(dir)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BreachCount(t.Context(), source, tt.password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("BreachCount(%q) = %d, want %d", tt.password, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package password
+
+import (
+	"math"
+	"unicode"
+)
+
+// Entropy estimates the strength of a password in bits. Each character is worth
+// log2 of the size of the alphabets the password draws from, except that
+// characters repeating the previous one or continuing a run like "abc" or
+// "321" add a single bit, since guessing tools try those patterns first.
+func Entropy(password string) float64 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+
+	perChar := math.Log2(float64(poolSize(password)))
+
+	bits := perChar
+	for i := 1; i < len(runes); i++ {
+		delta := runes[i] - runes[i-1]
+		predictable := delta == 0
+		if i >= 2 && (delta == 1 || delta == -1) && runes[i-1]-runes[i-2] == delta {
+			predictable = true
+		}
+		if predictable {
+			bits++
+		} else {
+			bits += perChar
+		}
+	}
+	return bits
+}
+
+// poolSize is the number of characters in the alphabets the password uses
+func poolSize(password string) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	if lower {
+		pool += 26
+	}
+	if upper {
+		pool += 26
+	}
+	if digit {
+		pool += 10
+	}
+	if symbol {
+		pool += 33
+	}
+	// Anything beyond ASCII draws from a much larger alphabet, counted conservatively
+	if other {
+		pool += 100
+	}
+	return pool
+}
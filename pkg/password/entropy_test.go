@@ -0,0 +1,37 @@
+package password
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEntropy(t *testing.T) {
+	lower := math.Log2(26)
+	digits := math.Log2(10)
+
+	tests := []struct {
+		name     string
+		password string
+		want     float64
+	}{
+		{name: "empty", password: "", want: 0},
+		{name: "single character", password: "a", want: lower},
+		{name: "unrelated characters", password: "qzmx", want: 4 * lower},
+		// The first character is worth the pool, every repeat one bit
+		{name: "repeats", password: "aaaa", want: lower + 3},
+		// A run only becomes predictable from its third character
+		{name: "ascending run", password: "abcd", want: 2*lower + 2},
+		{name: "descending run", password: "4321", want: 2*digits + 2},
+		{name: "broken run", password: "abce", want: 3*lower + 1},
+		{name: "all classes", password: "aB3$", want: 4 * math.Log2(26+26+10+33)},
+		{name: "beyond ascii", password: "äö", want: 2 * math.Log2(100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Entropy(tt.password); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Entropy(%q) = %.2f, want %.2f", tt.password, got, tt.want)
+			}
+		})
+	}
+}
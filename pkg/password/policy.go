@@ -0,0 +1,180 @@
+// Package password decides which passwords users may choose
+package password
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/NikSchaefer/go-fiber/config"
+)
+
+// Character classes a policy can require
+const (
+	ClassLower  = "lower"
+	ClassUpper  = "upper"
+	ClassDigit  = "digit"
+	ClassSymbol = "symbol"
+)
+
+// Policy is what a new password has to satisfy
+type Policy struct {
+	MinLength       int
+	MaxLength       int
+	RequiredClasses []string
+	// MinEntropy in bits, 0 disables the check
+	MinEntropy int
+	// Breaches is consulted when set, rejecting passwords seen BreachThreshold times or more
+	Breaches        RangeSource
+	BreachThreshold int
+}
+
+// PolicyError lists every rule a password broke, so the user can fix them at once
+type PolicyError struct {
+	Problems []string
+}
+
+func (e *PolicyError) Error() string {
+	return "Password " + strings.Join(e.Problems, ", ")
+}
+
+// policy is the global policy configured by the operator
+var policy Policy
+
+// InitPolicy loads the policy from the environment
+func InitPolicy() {
+	policy = Policy{
+		MinLength:       config.GetPasswordMinLength(),
+		MaxLength:       config.GetPasswordMaxLength(),
+		RequiredClasses: config.GetPasswordRequiredClasses(),
+		MinEntropy:      config.GetPasswordMinEntropy(),
+		BreachThreshold: config.GetPasswordBreachThreshold(),
+	}
+	if policy.MinLength > policy.MaxLength {
+		policy.MinLength = policy.MaxLength
+	}
+
+	for _, class := range policy.RequiredClasses {
+		switch class {
+		case ClassLower, ClassUpper, ClassDigit, ClassSymbol:
+		default:
+			log.Fatalf("Unknown PASSWORD_REQUIRED_CLASSES entry %q, use lower, upper, digit or symbol", class)
+		}
+	}
+
+	if dir := config.GetPasswordBreachDir(); dir != "" {
+		// Fail at startup rather than silently accepting breached passwords
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			log.Fatalf("PASSWORD_BREACH_DIR %q is not a directory", dir)
+		}
+		policy.Breaches = NewPrefixDirSource(dir)
+	}
+}
+
+// Validate checks a new password against the configured policy. userInputs are
+// things the user is known by, like their email and name, which the password
+// must not contain.
+func Validate(ctx context.Context, password string, userInputs ...string) error {
+	return policy.Validate(ctx, password, userInputs...)
+}
+
+// Validate checks a new password against the policy
+func (p Policy) Validate(ctx context.Context, password string, userInputs ...string) error {
+	var problems []string
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		problems = append(problems, fmt.Sprintf("must be at least %d characters", p.MinLength))
+	}
+	if length > p.MaxLength {
+		problems = append(problems, fmt.Sprintf("must be at most %d characters", p.MaxLength))
+	}
+
+	classes := characterClasses(password)
+	for _, class := range p.RequiredClasses {
+		if !classes[class] {
+			problems = append(problems, "must contain a "+classNames[class])
+		}
+	}
+
+	if input := containedUserInput(password, userInputs); input != "" {
+		problems = append(problems, "must not contain your "+input)
+	}
+
+	if p.MinEntropy > 0 && Entropy(password) < float64(p.MinEntropy) {
+		problems = append(problems, "is too easy to guess, try a longer or less predictable one")
+	}
+
+	// Only look up passwords that are otherwise fine, the rest is rejected anyway
+	if len(problems) == 0 && p.Breaches != nil {
+		count, err := BreachCount(ctx, p.Breaches, password)
+		if err != nil {
+			return err
+		}
+		if count > 0 && count >= p.BreachThreshold {
+			problems = append(problems, "has appeared in a data breach, choose a different one")
+		}
+	}
+
+	if len(problems) > 0 {
+		return &PolicyError{Problems: problems}
+	}
+	return nil
+}
+
+var classNames = map[string]string{
+	ClassLower:  "lowercase letter",
+	ClassUpper:  "uppercase letter",
+	ClassDigit:  "number",
+	ClassSymbol: "symbol",
+}
+
+func characterClasses(password string) map[string]bool {
+	classes := map[string]bool{}
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			classes[ClassLower] = true
+		case unicode.IsUpper(r):
+			classes[ClassUpper] = true
+		case unicode.IsDigit(r):
+			classes[ClassDigit] = true
+		case unicode.IsLetter(r):
+			// Letters of scripts without case count as lowercase
+			classes[ClassLower] = true
+		default:
+			classes[ClassSymbol] = true
+		}
+	}
+	return classes
+}
+
+// containedUserInput returns the kind of user input the password contains, if any.
+// Emails are checked whole and by their local part, names word by word.
+func containedUserInput(password string, userInputs []string) string {
+	lower := strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if input == "" {
+			continue
+		}
+
+		if local, _, ok := strings.Cut(input, "@"); ok {
+			if strings.Contains(lower, input) || (len(local) >= 3 && strings.Contains(lower, local)) {
+				return "email address"
+			}
+			continue
+		}
+
+		for _, part := range strings.Fields(input) {
+			if utf8.RuneCountInString(part) >= 3 && strings.Contains(lower, part) {
+				return "name"
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,136 @@
+package password
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	p := Policy{
+		MinLength:       8,
+		MaxLength:       20,
+		RequiredClasses: []string{ClassLower, ClassUpper, ClassDigit, ClassSymbol},
+	}
+	inputs := []string{"jane.doe@example.com", "Jane Doe"}
+
+	tests := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{name: "valid", password: "Tr0ub4dor&3x"},
+		{name: "shortest", password: "Tr0ub4d&"},
+		{name: "longest", password: "Tr0ub4dor&3xTr0ub4do"},
+		{name: "too short", password: "Tr0ub4&", want: []string{"must be at least 8 characters"}},
+		{name: "too long", password: "Tr0ub4dor&3xTr0ub4dor", want: []string{"must be at most 20 characters"}},
+		{name: "no lowercase", password: "TR0UB4DOR&3X", want: []string{"must contain a lowercase letter"}},
+		{name: "no uppercase", password: "tr0ub4dor&3x", want: []string{"must contain a uppercase letter"}},
+		{name: "no digit", password: "Troubador&xx", want: []string{"must contain a number"}},
+		{name: "no symbol", password: "Tr0ub4dor33x", want: []string{"must contain a symbol"}},
+		{name: "email local part", password: "A1!Jane.Doe", want: []string{"must not contain your email address"}},
+		{name: "name word", password: "Tr0ub4dor&Doe", want: []string{"must not contain your name"}},
+		{
+			name:     "every problem",
+			password: "doe",
+			want: []string{
+				"must be at least 8 characters",
+				"must contain a uppercase letter",
+				"must contain a number",
+				"must contain a symbol",
+				"must not contain your name",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(t.Context(), tt.password, inputs...)
+			expectProblems(t, err, tt.want)
+		})
+	}
+}
+
+func TestPolicyValidateUserInputs(t *testing.T) {
+	p := Policy{MinLength: 1, MaxLength: 128}
+
+	tests := []struct {
+		name     string
+		password string
+		inputs   []string
+		want     []string
+	}{
+		{name: "whole email", password: "my jo@example.com pw", inputs: []string{"jo@example.com"}, want: []string{"must not contain your email address"}},
+		// Local parts and name words under three characters are too common to reject
+		{name: "short local part", password: "my jo pw", inputs: []string{"jo@example.com"}},
+		{name: "short name word", password: "al is here", inputs: []string{"Al Smith"}},
+		{name: "name word", password: "agent SMITH", inputs: []string{"Al Smith"}, want: []string{"must not contain your name"}},
+		{name: "blank input", password: "anything", inputs: []string{"", "  "}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.Validate(t.Context(), tt.password, tt.inputs...)
+			expectProblems(t, err, tt.want)
+		})
+	}
+}
+
+func TestPolicyValidateEntropy(t *testing.T) {
+	p := Policy{MinLength: 1, MaxLength: 128, MinEntropy: 30}
+
+	expectProblems(t, p.Validate(t.Context(), "aaaaaaaaaaaaaaaaaaaa"), []string{"is too easy to guess, try a longer or less predictable one"})
+	expectProblems(t, p.Validate(t.Context(), "abcdefghijklmnopqrst"), []string{"is too easy to guess, try a longer or less predictable one"})
+	expectProblems(t, p.Validate(t.Context(), "plum rocket violin"), nil)
+}
+
+func TestPolicyValidateBreaches(t *testing.T) {
+	dir := t.TempDir()
+	prefix, suffix := hashRange("hunter2")
+	writeRange(t, dir, prefix, suffix+":17")
+
+	tests := []struct {
+		name      string
+		threshold int
+		want      []string
+	}{
+		{name: "seen more often than the threshold", threshold: 10, want: []string{"has appeared in a data breach, choose a different one"}},
+		{name: "seen exactly the threshold", threshold: 17, want: []string{"has appeared in a data breach, choose a different one"}},
+		{name: "seen less often than the threshold", threshold: 18},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Policy{MinLength: 1, MaxLength: 128, Breaches: NewPrefixDirSource(dir), BreachThreshold: tt.threshold}
+			expectProblems(t, p.Validate(t.Context(), "hunter2"), tt.want)
+		})
+	}
+}
+
+func TestPolicyErrorListsEveryProblem(t *testing.T) {
+	p := Policy{MinLength: 8, MaxLength: 128, RequiredClasses: []string{ClassDigit}}
+
+	err := p.Validate(t.Context(), "short")
+	if err == nil || err.Error() != "Password must be at least 8 characters, must contain a number" {
+		t.Errorf("error = %v", err)
+	}
+}
+
+func expectProblems(t *testing.T, err error, want []string) {
+	t.Helper()
+
+	if len(want) == 0 {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		return
+	}
+
+	var policyErr *PolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("error = %v, want a PolicyError", err)
+	}
+	if !slices.Equal(policyErr.Problems, want) {
+		t.Errorf("problems = %q, want %q", policyErr.Problems, want)
+	}
+}
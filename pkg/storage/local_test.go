@@ -0,0 +1,90 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStorageRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "http://localhost:3000/uploads")
+	ctx := context.Background()
+
+	data := []byte("\xFF\xD8\xFFjpeg bytes")
+	if err := s.Put(ctx, "users/1/v1-64.jpg", bytes.NewReader(data), int64(len(data)), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, "users", "1", "v1-64.jpg")
+	stored, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stored, data) {
+		t.Errorf("stored = %q, want %q", stored, data)
+	}
+
+	if got := s.URL("users/1/v1-64.jpg"); got != "http://localhost:3000/uploads/users/1/v1-64.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+
+	// Replacing leaves no temporary files behind
+	replacement := []byte("replaced")
+	if err := s.Put(ctx, "users/1/v1-64.jpg", bytes.NewReader(replacement), int64(len(replacement)), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	if stored, _ := os.ReadFile(path); !bytes.Equal(stored, replacement) {
+		t.Errorf("stored = %q after replacing", stored)
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("directory holds %d files, want 1", len(entries))
+	}
+
+	if err := s.Delete(ctx, "users/1/v1-64.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("file not deleted: %v", err)
+	}
+	if err := s.Delete(ctx, "users/1/v1-64.jpg"); err != nil {
+		t.Errorf("deleting a missing file: %v", err)
+	}
+}
+
+func TestLocalStorageShortBody(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "")
+
+	if err := s.Put(context.Background(), "a.jpg", strings.NewReader("abc"), 10, "image/jpeg"); err == nil {
+		t.Fatal("stored a body shorter than its size")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "a.jpg")); !os.IsNotExist(err) {
+		t.Errorf("partial file left behind: %v", err)
+	}
+}
+
+func TestLocalStorageRejectsInvalidKeys(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "uploads")
+	s := NewLocalStorage(dir, "")
+
+	for _, key := range []string{"", "/", "../escape.jpg", "users/../../escape.jpg", "users//a.jpg", "users/./a.jpg"} {
+		if err := s.Put(context.Background(), key, strings.NewReader("x"), 1, "image/jpeg"); err != ErrInvalidKey {
+			t.Errorf("Put(%q) = %v, want ErrInvalidKey", key, err)
+		}
+		if err := s.Delete(context.Background(), key); err != ErrInvalidKey {
+			t.Errorf("Delete(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(root, "escape.jpg")); !os.IsNotExist(err) {
+		t.Error("file written outside the storage directory")
+	}
+}
@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 is a path style S3 stand-in keeping objects in memory
+type fakeS3 struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	bucket  string
+	objects map[string]fakeObject
+}
+
+type fakeObject struct {
+	body         []byte
+	contentType  string
+	cacheControl string
+}
+
+func newFakeS3(t *testing.T, bucket string) *fakeS3 {
+	t.Helper()
+
+	s := &fakeS3{bucket: bucket, objects: make(map[string]fakeObject)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeS3) serve(w http.ResponseWriter, r *http.Request) {
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != s.bucket {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = io.WriteString(w, `<Error><Code>NoSuchBucket</Code></Error>`)
+		return
+	}
+	if r.Header.Get("Authorization") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := readPayload(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.objects[key] = fakeObject{
+			body:         body,
+			contentType:  r.Header.Get("Content-Type"),
+			cacheControl: r.Header.Get("Cache-Control"),
+		}
+		w.Header().Set("ETag", `"etag"`)
+	case http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readPayload reads an upload body, decoding the aws-chunked encoding clients
+// use for signed streaming uploads over plain HTTP
+func readPayload(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	var body bytes.Buffer
+	reader := bufio.NewReader(r.Body)
+	for {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(header), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, err
+		}
+		if size == 0 {
+			return body.Bytes(), nil
+		}
+		if _, err := io.CopyN(&body, reader, size); err != nil {
+			return nil, err
+		}
+		if _, err := reader.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *fakeS3) object(key string) (fakeObject, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[key]
+	return obj, ok
+}
+
+func newTestS3Storage(t *testing.T, server *fakeS3, bucket string) Storage {
+	t.Helper()
+
+	s, err := NewS3Storage(S3Config{
+		Endpoint:  strings.TrimPrefix(server.URL, "http://"),
+		Bucket:    bucket,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+		PathStyle: true,
+		PublicURL: "https://cdn.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestS3StorageRoundTrip(t *testing.T) {
+	server := newFakeS3(t, "avatars")
+	s := newTestS3Storage(t, server, "avatars")
+	ctx := context.Background()
+
+	data := []byte("\xFF\xD8\xFFjpeg bytes")
+	if err := s.Put(ctx, "users/1/v1-64.jpg", bytes.NewReader(data), int64(len(data)), "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+
+	obj, ok := server.object("users/1/v1-64.jpg")
+	if !ok {
+		t.Fatal("object not stored")
+	}
+	if !bytes.Equal(obj.body, data) {
+		t.Errorf("body = %q, want %q", obj.body, data)
+	}
+	if obj.contentType != "image/jpeg" {
+		t.Errorf("content type = %q", obj.contentType)
+	}
+	if !strings.Contains(obj.cacheControl, "immutable") {
+		t.Errorf("cache control = %q, want immutable", obj.cacheControl)
+	}
+
+	if got := s.URL("users/1/v1-64.jpg"); got != "https://cdn.example.com/users/1/v1-64.jpg" {
+		t.Errorf("URL = %q", got)
+	}
+
+	if err := s.Delete(ctx, "users/1/v1-64.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := server.object("users/1/v1-64.jpg"); ok {
+		t.Error("object not deleted")
+	}
+	if err := s.Delete(ctx, "users/1/v1-64.jpg"); err != nil {
+		t.Errorf("deleting a missing object: %v", err)
+	}
+}
+
+func TestS3StorageRejectsInvalidKeys(t *testing.T) {
+	server := newFakeS3(t, "avatars")
+	s := newTestS3Storage(t, server, "avatars")
+
+	for _, key := range []string{"", "../escape.jpg", "users/../../escape.jpg"} {
+		if err := s.Put(context.Background(), key, strings.NewReader("x"), 1, "image/jpeg"); err != ErrInvalidKey {
+			t.Errorf("Put(%q) = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	if len(server.objects) != 0 {
+		t.Errorf("stored %d objects", len(server.objects))
+	}
+}
+
+func TestS3StorageMissingBucket(t *testing.T) {
+	server := newFakeS3(t, "avatars")
+	s := newTestS3Storage(t, server, "other")
+
+	if err := s.Put(context.Background(), "a.jpg", strings.NewReader("x"), 1, "image/jpeg"); err == nil {
+		t.Fatal("upload to a missing bucket succeeded")
+	}
+}
+
+func TestNewS3StorageRequiresBucket(t *testing.T) {
+	if _, err := NewS3Storage(S3Config{Endpoint: "localhost:9000"}); err == nil {
+		t.Fatal("created storage without a bucket")
+	}
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/NikSchaefer/go-fiber/config"
+)
+
+// Storage keeps uploaded files and links to them publicly
+type Storage interface {
+	// Put stores size bytes read from body under key, replacing any existing file
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	// Delete removes the file under key, missing files are not an error
+	Delete(ctx context.Context, key string) error
+	// URL is where the file under key can be downloaded from
+	URL(key string) string
+}
+
+// ErrInvalidKey is returned for keys that are empty or try to leave the storage root
+var ErrInvalidKey = errors.New("invalid storage key")
+
+// backend is the global storage instance
+var backend Storage
+
+// InitStorage sets up the backend selected by STORAGE_DRIVER
+func InitStorage() {
+	switch config.GetStorageDriver() {
+	case "s3":
+		s, err := NewS3Storage(S3Config{
+			Endpoint:  config.GetS3Endpoint(),
+			Bucket:    config.GetS3Bucket(),
+			Region:    config.GetS3Region(),
+			AccessKey: config.GetS3AccessKey(),
+			SecretKey: config.GetS3SecretKey(),
+			UseSSL:    config.GetS3UseSSL(),
+			PathStyle: config.GetS3PathStyle(),
+			PublicURL: config.GetStoragePublicURL(),
+		})
+		if err != nil {
+			log.Fatal("Failed to initialize S3 storage: ", err)
+		}
+		backend = s
+	case "local":
+		backend = NewLocalStorage(config.GetStorageLocalDir(), config.GetStoragePublicURL())
+	default:
+		log.Fatalf("Unknown STORAGE_DRIVER %q, use local or s3", config.GetStorageDriver())
+	}
+}
+
+// Put stores a file with the global backend
+func Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	return backend.Put(ctx, key, body, size, contentType)
+}
+
+// Delete removes a file from the global backend
+func Delete(ctx context.Context, key string) error {
+	return backend.Delete(ctx, key)
+}
+
+// URL links to a file in the global backend
+func URL(key string) string {
+	return backend.URL(key)
+}
+
+// cleanKey normalizes a key to a relative slash separated path
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean("/" + key)[1:]
+	if cleaned == "" || cleaned != strings.TrimPrefix(key, "/") {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
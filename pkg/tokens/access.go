@@ -0,0 +1,74 @@
+package tokens
+
+import (
+	"errors"
+	"time"
+
+	"github.com/NikSchaefer/go-fiber/config"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// AccessClaims are the claims carried by an access token
+type AccessClaims struct {
+	jwt.RegisteredClaims
+	// SessionID identifies the refresh token family the access token was issued for
+	SessionID string `json:"sid"`
+}
+
+// IssueAccessToken signs a short-lived access token for the user
+func IssueAccessToken(userID uuid.UUID, sessionID uuid.UUID) (string, time.Time, error) {
+	if keySet == nil {
+		return "", time.Time{}, errors.New("signing keys not initialized")
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(config.GetAccessTokenTTL())
+
+	claims := AccessClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    config.GetJWTIssuer(),
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		SessionID: sessionID.String(),
+	}
+
+	key := keySet.active()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = key.id
+
+	signed, err := token.SignedString(key.privateKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// ParseAccessToken verifies the signature, issuer and lifetime of an access token
+func ParseAccessToken(raw string) (*AccessClaims, error) {
+	if keySet == nil {
+		return nil, errors.New("signing keys not initialized")
+	}
+
+	claims := &AccessClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keySet.publicKey(kid)
+		if !ok {
+			return nil, errors.New("unknown signing key")
+		}
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg()}),
+		jwt.WithIssuer(config.GetJWTIssuer()),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
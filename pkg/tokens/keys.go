@@ -0,0 +1,165 @@
+package tokens
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"os"
+
+	"github.com/NikSchaefer/go-fiber/config"
+)
+
+// signingKey is an RSA key identified by its RFC 7638 thumbprint
+type signingKey struct {
+	id         string
+	privateKey *rsa.PrivateKey
+}
+
+// KeySet holds the keys used to sign and verify access tokens. The first key
+// signs new tokens, the remaining ones are only kept around to verify tokens
+// issued before a rotation.
+type KeySet struct {
+	keys []*signingKey
+}
+
+// JSONWebKey is the public part of a signing key as published in the JWKS
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// keySet is the global key set instance
+var keySet *KeySet
+
+// InitKeys loads the configured signing keys. Outside production an ephemeral
+// key is generated when none are configured, which invalidates tokens on restart.
+func InitKeys() {
+	paths := config.GetJWTSigningKeyPaths()
+	if len(paths) == 0 {
+		if config.GetIsProduction() {
+			log.Fatal("JWT_SIGNING_KEYS must be set in production")
+		}
+
+		log.Println("JWT_SIGNING_KEYS not set, generating an ephemeral signing key")
+		privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			log.Fatal("Failed to generate signing key: ", err)
+		}
+		keySet = &KeySet{keys: []*signingKey{newSigningKey(privateKey)}}
+		return
+	}
+
+	set, err := LoadKeySet(paths)
+	if err != nil {
+		log.Fatal("Failed to load signing keys: ", err)
+	}
+	keySet = set
+}
+
+// LoadKeySet reads PEM encoded RSA private keys (PKCS#1 or PKCS#8) from disk,
+// the first path being the active signing key
+func LoadKeySet(paths []string) (*KeySet, error) {
+	set := &KeySet{}
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		privateKey, err := parseRSAPrivateKey(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		set.keys = append(set.keys, newSigningKey(privateKey))
+	}
+	if len(set.keys) == 0 {
+		return nil, errors.New("no signing keys provided")
+	}
+	return set, nil
+}
+
+func parseRSAPrivateKey(raw []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key must be an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func newSigningKey(privateKey *rsa.PrivateKey) *signingKey {
+	return &signingKey{
+		id:         thumbprint(&privateKey.PublicKey),
+		privateKey: privateKey,
+	}
+}
+
+// thumbprint computes the RFC 7638 JWK thumbprint used as key ID
+func thumbprint(publicKey *rsa.PublicKey) string {
+	// Members must be in lexicographic order with no whitespace
+	canonical := fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, encodeExponent(publicKey.E), encodeBigInt(publicKey.N))
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func encodeBigInt(n *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(n.Bytes())
+}
+
+func encodeExponent(e int) string {
+	return encodeBigInt(big.NewInt(int64(e)))
+}
+
+// active returns the key new tokens are signed with
+func (k *KeySet) active() *signingKey {
+	return k.keys[0]
+}
+
+// publicKey looks up a verification key by ID
+func (k *KeySet) publicKey(id string) (crypto.PublicKey, bool) {
+	for _, key := range k.keys {
+		if key.id == id {
+			return &key.privateKey.PublicKey, true
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public keys in JSON Web Key Set format
+func JWKS() map[string][]JSONWebKey {
+	keys := make([]JSONWebKey, 0, len(keySet.keys))
+	for _, key := range keySet.keys {
+		keys = append(keys, JSONWebKey{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: "RS256",
+			Kid: key.id,
+			N:   encodeBigInt(key.privateKey.N),
+			E:   encodeExponent(key.privateKey.E),
+		})
+	}
+	return map[string][]JSONWebKey{"keys": keys}
+}
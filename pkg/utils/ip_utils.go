@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"net"
+	"strings"
+)
+
+// ParseTrustedProxies converts a list of IPs and CIDR ranges into networks,
+// skipping entries that cannot be parsed
+func ParseTrustedProxies(entries []string) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(entry)
+		if err != nil {
+			continue
+		}
+		networks = append(networks, network)
+	}
+	return networks
+}
+
+func isTrusted(ip string, trusted []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, network := range trusted {
+		if network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP resolves the originating client address. X-Forwarded-For is only
+// honoured when the direct peer is a trusted proxy, and is walked from the
+// right so that entries appended by trusted proxies are skipped while a
+// client-supplied prefix cannot spoof the result.
+func ClientIP(remoteIP string, forwardedFor []string, trusted []*net.IPNet) string {
+	if len(forwardedFor) == 0 || !isTrusted(remoteIP, trusted) {
+		return remoteIP
+	}
+
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(forwardedFor[i])
+		if net.ParseIP(ip) == nil {
+			break
+		}
+		if i == 0 || !isTrusted(ip, trusted) {
+			return ip
+		}
+	}
+
+	return remoteIP
+}
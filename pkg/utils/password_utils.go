@@ -1,14 +1,27 @@
 package utils
 
 import (
-	"errors"
-	"unicode"
+	"crypto/sha256"
+	"encoding/base64"
 
 	"golang.org/x/crypto/bcrypt"
 )
 
+// bcryptInput prehashes passwords longer than the 72 bytes bcrypt reads, so
+// every byte of a long password counts. Shorter passwords are hashed as they
+// are, keeping existing hashes valid.
+func bcryptInput(pwd []byte) []byte {
+	if len(pwd) <= 72 {
+		return pwd
+	}
+	sum := sha256.Sum256(pwd)
+	return []byte(base64.StdEncoding.EncodeToString(sum[:]))
+}
+
+// HashAndSalt hashes a password for storage. It doesn't check the password
+// against any policy, use services.HashNewPassword for passwords users choose.
 func HashAndSalt(pwd []byte) ([]byte, error) {
-	hash, err := bcrypt.GenerateFromPassword(pwd, bcrypt.DefaultCost)
+	hash, err := bcrypt.GenerateFromPassword(bcryptInput(pwd), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, err
 	}
@@ -18,40 +31,6 @@ func ComparePasswords(hashedPwd []byte, plainPwd []byte) bool {
 	if len(hashedPwd) == 0 || len(plainPwd) == 0 {
 		return false
 	}
-	err := bcrypt.CompareHashAndPassword(hashedPwd, plainPwd)
+	err := bcrypt.CompareHashAndPassword(hashedPwd, bcryptInput(plainPwd))
 	return err == nil
 }
-
-func SaltAndVerifyPassword(s string) (pw []byte, err error) {
-	if s == "" {
-		return nil, errors.New("password cannot be empty")
-	}
-
-	letters := 0
-	number := false
-	for _, c := range s {
-		switch {
-		case unicode.IsNumber(c):
-			number = true
-		case unicode.IsLetter(c) || c == ' ':
-			letters++
-		default:
-		}
-	}
-	if letters <= 4 {
-		return nil, errors.New("password must contain at least 5 letters")
-	}
-	if !number {
-		return nil, errors.New("password must contain at least 1 number")
-	}
-	length := len(s)
-	if length < 8 || length > 30 {
-		return nil, errors.New("password must be between 8 and 30 characters")
-	}
-
-	hashedPw, err := HashAndSalt([]byte(s))
-	if err != nil {
-		return nil, err
-	}
-	return hashedPw, nil
-}